@@ -0,0 +1,121 @@
+package brightbox
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	accountAttribute = "account"
+)
+
+// addResourceAccountOverride gives a resource an optional account
+// attribute and switches the client used by its operations to match
+func addResourceAccountOverride(resource *schema.Resource) {
+	if _, ok := resource.Schema[accountAttribute]; !ok {
+		resource.Schema[accountAttribute] = &schema.Schema{
+			Description:  "The account to operate upon, if different from the provider account",
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringMatch(accountRegexp, "must be a valid account ID"),
+		}
+	}
+	resource.CreateContext = withAccountClient(resource.CreateContext)
+	resource.ReadContext = withAccountClient(resource.ReadContext)
+	resource.UpdateContext = withAccountClient(resource.UpdateContext)
+	resource.DeleteContext = withAccountClient(resource.DeleteContext)
+	if resource.Importer != nil {
+		resource.Importer = accountImporter(resource.Importer)
+	}
+}
+
+// addDataSourceAccountOverride gives a data source an optional account
+// attribute and switches the client used by its read to match
+func addDataSourceAccountOverride(resource *schema.Resource) {
+	if _, ok := resource.Schema[accountAttribute]; !ok {
+		resource.Schema[accountAttribute] = &schema.Schema{
+			Description:  "The account to search, if different from the provider account",
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringMatch(accountRegexp, "must be a valid account ID"),
+		}
+	}
+	resource.ReadContext = withAccountClient(resource.ReadContext)
+}
+
+// accountClient returns the Composite client for the account selected
+// by the resource, falling back to the provider account
+func accountClient(
+	ctx context.Context,
+	d *schema.ResourceData,
+	meta interface{},
+) (*CompositeClient, diag.Diagnostics) {
+	client := meta.(*CompositeClient)
+	account, _ := d.Get(accountAttribute).(string)
+	return client.ForAccount(ctx, account)
+}
+
+func withAccountClient[F ~func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics](
+	operation F,
+) F {
+	if operation == nil {
+		return nil
+	}
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		client, diags := accountClient(ctx, d, meta)
+		if diags.HasError() {
+			return diags
+		}
+		diags = append(diags, operation(ctx, d, client)...)
+		if d.Id() != "" {
+			if err := d.Set(accountAttribute, client.Account); err != nil {
+				diags = append(diags, diag.Errorf("unexpected: %s", err)...)
+			}
+		}
+		return diags
+	}
+}
+
+// splitAccountImportID separates an optional "acc-xxxxx/" prefix from
+// an import identifier
+func splitAccountImportID(importID string) (string, string) {
+	account, remainder, found := strings.Cut(importID, "/")
+	if found && accountRegexp.MatchString(account) {
+		return account, remainder
+	}
+	return "", importID
+}
+
+func accountImporter(importer *schema.ResourceImporter) *schema.ResourceImporter {
+	return &schema.ResourceImporter{
+		StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+			account, importID := splitAccountImportID(d.Id())
+			if account != "" {
+				tflog.Debug(ctx, "Importing from account", map[string]interface{}{
+					logFieldResourceID: importID,
+					logFieldAccount:    account,
+				})
+				d.SetId(importID)
+				if err := d.Set(accountAttribute, account); err != nil {
+					return nil, err
+				}
+			}
+			client, diags := accountClient(ctx, d, meta)
+			if diags.HasError() {
+				return nil, diagnosticsError(diags)
+			}
+			if importer.StateContext != nil {
+				return importer.StateContext(ctx, d, client)
+			}
+			return importer.State(d, client)
+		},
+	}
+}
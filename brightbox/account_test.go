@@ -0,0 +1,26 @@
+package brightbox
+
+import (
+	"testing"
+)
+
+func TestSplitAccountImportID(t *testing.T) {
+	testCases := []struct {
+		in      string
+		account string
+		id      string
+	}{
+		{"srv-12345", "", "srv-12345"},
+		{"acc-12345/srv-12345", "acc-12345", "srv-12345"},
+		{"acc-12345/grp-12345/srv-12345", "acc-12345", "grp-12345/srv-12345"},
+		{"grp-12345/srv-12345", "", "grp-12345/srv-12345"},
+		{"acc-123/srv-12345", "", "acc-123/srv-12345"},
+		{"container/with/slashes", "", "container/with/slashes"},
+	}
+	for _, tcase := range testCases {
+		account, id := splitAccountImportID(tcase.in)
+		if account != tcase.account || id != tcase.id {
+			t.Errorf("%q split incorrectly, expected (%q, %q), got (%q, %q)", tcase.in, tcase.account, tcase.id, account, id)
+		}
+	}
+}
@@ -0,0 +1,166 @@
+package brightbox
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	brightbox "github.com/brightbox/gobrightbox/v2"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+var (
+	_ action.Action              = (*commandAction)(nil)
+	_ action.ActionWithConfigure = (*commandAction)(nil)
+)
+
+// commandAction is a plugin framework action that issues a one-off
+// command to an object, then waits for the object the command affects
+// to settle. Commands with nothing to wait upon use report instead.
+type commandAction struct {
+	frameworkClient
+	typeName    string
+	description string
+	objectName  string
+	attribute   string
+	pattern     *regexp.Regexp
+	// command issues the command, returning the ID of the object to
+	// wait upon
+	command func(ctx context.Context, client *brightbox.Client, id string) (string, error)
+	// report issues a command that completes immediately, returning a
+	// message for the user rather than an object to wait upon
+	report func(ctx context.Context, client *brightbox.Client, id string) (string, error)
+	// secrets names the secrets the API returns, masked in the logs
+	secrets []string
+	refresh func(client *brightbox.Client, ctx context.Context, id string) retry.StateRefreshFunc
+	pending []string
+	target  []string
+}
+
+func (a *commandAction) Metadata(
+	_ context.Context,
+	req action.MetadataRequest,
+	resp *action.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_" + a.typeName
+}
+
+func (a *commandAction) Schema(
+	_ context.Context,
+	_ action.SchemaRequest,
+	resp *action.SchemaResponse,
+) {
+	attributes := map[string]schema.Attribute{
+		accountAttribute: schema.StringAttribute{
+			Description: "The account to operate upon, if different from the provider account",
+			Optional:    true,
+			Validators: []validator.String{
+				stringvalidator.RegexMatches(accountRegexp, "must be a valid account ID"),
+			},
+		},
+		a.attribute: schema.StringAttribute{
+			Description: fmt.Sprintf("The ID of the %s", a.objectName),
+			Required:    true,
+			Validators: []validator.String{
+				stringvalidator.RegexMatches(a.pattern, fmt.Sprintf("must be a valid %s ID", a.objectName)),
+			},
+		},
+	}
+	if a.report == nil {
+		attributes["timeout"] = schema.StringAttribute{
+			Description: fmt.Sprintf("How long to wait for the command to complete. Defaults to %s", defaultTimeout),
+			Optional:    true,
+		}
+	}
+	resp.Schema = schema.Schema{
+		Description: a.description,
+		Attributes:  attributes,
+	}
+}
+
+func (a *commandAction) Configure(
+	_ context.Context,
+	req action.ConfigureRequest,
+	resp *action.ConfigureResponse,
+) {
+	a.configure(req.ProviderData, &resp.Diagnostics)
+}
+
+func (a *commandAction) Invoke(
+	ctx context.Context,
+	req action.InvokeRequest,
+	resp *action.InvokeResponse,
+) {
+	var account, id, timeoutValue types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(accountAttribute), &account)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(a.attribute), &id)...)
+	if a.report == nil {
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("timeout"), &timeoutValue)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	timeout := defaultTimeout
+	if !timeoutValue.IsNull() {
+		var err error
+		timeout, err = time.ParseDuration(timeoutValue.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("timeout"), "Invalid timeout", err.Error())
+			return
+		}
+	}
+	ctx = contextWithFrameworkLogging(ctx, "brightbox_"+a.typeName, id.ValueString(), a.secrets...)
+	client, diags := a.forAccount(ctx, account)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Invoking action")
+	if a.report != nil {
+		message, err := a.report(ctx, client.APIClient, id.ValueString())
+		if err != nil {
+			resp.Diagnostics.Append(frameworkDiagnostics(brightboxFromErrSlice(err))...)
+			return
+		}
+		progress(resp, message)
+		return
+	}
+	waitID, err := a.command(ctx, client.APIClient, id.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(frameworkDiagnostics(brightboxFromErrSlice(err))...)
+		return
+	}
+	progress(resp, fmt.Sprintf("Waiting for %s to become %v", waitID, a.target))
+	tflog.SubsystemInfo(ctx, waitSubsystem, "Waiting for action to complete", map[string]interface{}{
+		logFieldTarget: waitID,
+	})
+	stateConf := retry.StateChangeConf{
+		Pending:    a.pending,
+		Target:     a.target,
+		Refresh:    a.refresh(client.APIClient, ctx, waitID),
+		Timeout:    timeout,
+		MinTimeout: minimumRefreshWait,
+	}
+	if _, err := waitForState(ctx, &stateConf); err != nil {
+		resp.Diagnostics.Append(frameworkDiagnostics(brightboxFromErrSlice(err))...)
+		return
+	}
+	progress(resp, fmt.Sprintf("%s is %v", waitID, a.target))
+}
+
+// progress reports the progress of an action to Terraform, if it is
+// listening
+func progress(resp *action.InvokeResponse, message string) {
+	if resp.SendProgress != nil {
+		resp.SendProgress(action.InvokeProgressEvent{Message: message})
+	}
+}
@@ -0,0 +1,29 @@
+package brightbox
+
+import (
+	"context"
+
+	brightbox "github.com/brightbox/gobrightbox/v2"
+	"github.com/brightbox/gobrightbox/v2/enums/databaseserverstatus"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+)
+
+func newDatabaseServerResetAction() action.Action {
+	return &commandAction{
+		typeName:    "database_server_reset",
+		description: "Restarts the database process of a Brightbox Database Server and waits for it to become active",
+		objectName:  "database server",
+		attribute:   "database_server",
+		pattern:     databaseServerRegexp,
+		command: func(ctx context.Context, client *brightbox.Client, id string) (string, error) {
+			databaseServer, err := client.ResetDatabaseServer(ctx, id)
+			if err != nil {
+				return "", err
+			}
+			return databaseServer.ID, nil
+		},
+		refresh: databaseServerStateRefresh,
+		pending: []string{databaseserverstatus.Creating.String()},
+		target:  []string{databaseserverstatus.Active.String()},
+	}
+}
@@ -0,0 +1,62 @@
+package brightbox
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestDatabaseServerResetAction(t *testing.T) {
+	var resets int
+	client := newFakeAPIClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/1.0/database_servers/dbs-abcde/reset":
+			resets++
+			w.WriteHeader(http.StatusAccepted)
+			w.Write([]byte(`{"id":"dbs-abcde","status":"active"}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/1.0/database_servers/dbs-abcde":
+			w.Write([]byte(`{"id":"dbs-abcde","status":"active"}`))
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			http.NotFound(w, r)
+		}
+	})
+	diags, _ := invokeAction(t, newDatabaseServerResetAction(), client, map[string]tftypes.Value{
+		"database_server": tftypes.NewValue(tftypes.String, "dbs-abcde"),
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if resets != 1 {
+		t.Errorf("expected a single reset, got %d", resets)
+	}
+}
+
+func TestDatabaseServerResetActionFailing(t *testing.T) {
+	var polls int
+	client := newFakeAPIClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/1.0/database_servers/dbs-abcde/reset":
+			w.WriteHeader(http.StatusAccepted)
+			w.Write([]byte(`{"id":"dbs-abcde","status":"active"}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/1.0/database_servers/dbs-abcde":
+			polls++
+			w.Write([]byte(`{"id":"dbs-abcde","status":"failing"}`))
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			http.NotFound(w, r)
+		}
+	})
+	diags, _ := invokeAction(t, newDatabaseServerResetAction(), client, map[string]tftypes.Value{
+		"database_server": tftypes.NewValue(tftypes.String, "dbs-abcde"),
+	})
+	if !diags.HasError() {
+		t.Fatal("expected an error")
+	}
+	if polls != 1 {
+		t.Errorf("expected the action to fail on the first poll, got %d polls", polls)
+	}
+}
@@ -0,0 +1,157 @@
+package brightbox
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"time"
+
+	brightbox "github.com/brightbox/gobrightbox/v2"
+	"github.com/brightbox/gobrightbox/v2/enums/imagestatus"
+	"github.com/brightbox/gobrightbox/v2/enums/serverstatus"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+// imageLinkRegexp extracts the image ID from the Link header returned
+// by a server snapshot request
+var imageLinkRegexp = regexp.MustCompile(`/images/(img-[a-z0-9]{5})\b`)
+
+func newServerRebootAction() action.Action {
+	return serverCommandAction(
+		"server_reboot",
+		"Sends an ACPI reboot request to a Brightbox Server. A server stays active while it reboots, so this waits only until the server is active, not for the reboot to finish",
+		(*brightbox.Client).RebootServer,
+	)
+}
+
+func newServerResetAction() action.Action {
+	return serverCommandAction(
+		"server_reset",
+		"Hard resets a Brightbox Server. A server stays active while it resets, so this waits only until the server is active, not for the reset to finish",
+		(*brightbox.Client).ResetServer,
+	)
+}
+
+func newServerSnapshotNowAction() action.Action {
+	return &commandAction{
+		typeName:    "server_snapshot_now",
+		description: "Snapshots the disk of a Brightbox Server and waits for the resulting image to become available",
+		objectName:  "server",
+		attribute:   "server",
+		pattern:     serverRegexp,
+		command:     snapshotServer,
+		refresh:     imageStateRefresh,
+		pending:     []string{imagestatus.Creating.String()},
+		target:      []string{imagestatus.Available.String()},
+	}
+}
+
+func newServerActivateConsoleAction() action.Action {
+	return &commandAction{
+		typeName:    "server_activate_console",
+		description: "Activates the graphical console of a Brightbox Server, reporting its URL. The console password is not reported",
+		objectName:  "server",
+		attribute:   "server",
+		pattern:     serverRegexp,
+		report:      activateServerConsole,
+		secrets:     []string{"console_token"},
+	}
+}
+
+// activateServerConsole activates the console of a server, returning
+// how to reach it. The console is ready as soon as the call returns.
+// Progress messages end up in CLI and CI logs, so the password is left
+// for the user to look up.
+func activateServerConsole(ctx context.Context, client *brightbox.Client, id string) (string, error) {
+	server, err := client.ActivateConsoleForServer(ctx, id)
+	if err != nil {
+		return "", err
+	}
+	if server.ConsoleURL == nil {
+		return "", fmt.Errorf("console activation of %s did not return the console details", id)
+	}
+	message := fmt.Sprintf("Console for %s is at %s", server.ID, *server.ConsoleURL)
+	if server.ConsoleTokenExpires != nil {
+		message += fmt.Sprintf(" until %s", server.ConsoleTokenExpires.Format(time.RFC3339))
+	}
+	return message + fmt.Sprintf(". Run `brightbox servers show %s` for its password", server.ID), nil
+}
+
+// serverCommandAction is an action issuing a power command to a server
+func serverCommandAction(
+	typeName string,
+	description string,
+	command func(*brightbox.Client, context.Context, string) (*brightbox.Server, error),
+) action.Action {
+	return &commandAction{
+		typeName:    typeName,
+		description: description,
+		objectName:  "server",
+		attribute:   "server",
+		pattern:     serverRegexp,
+		command: func(ctx context.Context, client *brightbox.Client, id string) (string, error) {
+			server, err := command(client, ctx, id)
+			if err != nil {
+				return "", err
+			}
+			return server.ID, nil
+		},
+		refresh: serverStateRefresh,
+		pending: []string{
+			serverstatus.Creating.String(),
+			serverstatus.Inactive.String(),
+			serverstatus.Unavailable.String(),
+		},
+		target: []string{serverstatus.Active.String()},
+	}
+}
+
+// snapshotServer snapshots the disk of a server, returning the ID of the
+// new image. The API client library has no call for this, so the
+// request is made directly with its authenticated HTTP client.
+func snapshotServer(ctx context.Context, client *brightbox.Client, id string) (string, error) {
+	snapshotURL, err := client.ResourceBaseURL().Parse("servers/" + id + "/snapshot")
+	if err != nil {
+		return "", err
+	}
+	snapshotURL.RawQuery = client.ResourceBaseURL().RawQuery
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, snapshotURL.String(), nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Add("Accept", "application/json")
+	res, err := client.HTTPClient().Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer res.Body.Close()
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return "", newAPIError(res)
+	}
+	match := imageLinkRegexp.FindStringSubmatch(res.Header.Get("Link"))
+	if match == nil {
+		return "", fmt.Errorf("snapshot of %s did not return the new image", id)
+	}
+	return match[1], nil
+}
+
+func imageStateRefresh(client *brightbox.Client, ctx context.Context, imageID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		image, err := client.Image(ctx, imageID)
+		if err != nil {
+			tflog.SubsystemError(ctx, waitSubsystem, "Error on Image State Refresh", map[string]interface{}{
+				logFieldResourceID: imageID,
+				"error":            err.Error(),
+			})
+			return nil, "", err
+		}
+		tflog.SubsystemTrace(ctx, waitSubsystem, "Image State Refresh", map[string]interface{}{
+			logFieldResourceID: imageID,
+			"status":           image.Status.String(),
+		})
+		return image, image.Status.String(), nil
+	}
+}
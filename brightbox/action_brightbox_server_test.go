@@ -0,0 +1,125 @@
+package brightbox
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+)
+
+func serverActionHandler(t *testing.T, command string, calls *int) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/1.0/servers/srv-abcde/"+command:
+			*calls++
+			switch command {
+			case "snapshot":
+				w.Header().Set("Link", "<https://api.gb1.brightbox.com/1.0/images/img-12345>; rel=snapshot")
+				w.WriteHeader(http.StatusAccepted)
+				return
+			case "activate_console":
+				w.WriteHeader(http.StatusAccepted)
+				w.Write([]byte(`{"id":"srv-abcde","status":"active","console_url":"https://console.example.com/","console_token":"c0ns0le-t0ken","console_token_expires":"2026-10-18T12:00:00Z"}`))
+				return
+			}
+			w.WriteHeader(http.StatusAccepted)
+			w.Write([]byte(`{"id":"srv-abcde","status":"active"}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/1.0/servers/srv-abcde":
+			w.Write([]byte(`{"id":"srv-abcde","status":"active"}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/1.0/images/img-12345":
+			w.Write([]byte(`{"id":"img-12345","status":"available"}`))
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			http.NotFound(w, r)
+		}
+	}
+}
+
+func TestServerActions(t *testing.T) {
+	testCases := []struct {
+		name    string
+		command string
+		newFunc func() action.Action
+	}{
+		{"server_reboot", "reboot", newServerRebootAction},
+		{"server_reset", "reset", newServerResetAction},
+		{"server_snapshot_now", "snapshot", newServerSnapshotNowAction},
+	}
+	for _, tcase := range testCases {
+		t.Run(tcase.name, func(t *testing.T) {
+			var calls int
+			client := newFakeAPIClient(t, serverActionHandler(t, tcase.command, &calls))
+			diags, messages := invokeAction(t, tcase.newFunc(), client, map[string]tftypes.Value{
+				"server": tftypes.NewValue(tftypes.String, "srv-abcde"),
+			})
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+			if calls != 1 {
+				t.Errorf("expected a single %s request, got %d", tcase.command, calls)
+			}
+			if len(messages) != 2 {
+				t.Errorf("expected two progress messages, got %v", messages)
+			}
+		})
+	}
+}
+
+func TestServerActivateConsoleAction(t *testing.T) {
+	var calls int
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+	client := newFakeAPIClient(t, serverActionHandler(t, "activate_console", &calls))
+	diags, messages := invokeActionContext(ctx, t, newServerActivateConsoleAction(), client, map[string]tftypes.Value{
+		"server": tftypes.NewValue(tftypes.String, "srv-abcde"),
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if calls != 1 {
+		t.Errorf("expected a single activate_console request, got %d", calls)
+	}
+	expected := "Console for srv-abcde is at https://console.example.com/ until 2026-10-18T12:00:00Z. " +
+		"Run `brightbox servers show srv-abcde` for its password"
+	if len(messages) != 1 || messages[0] != expected {
+		t.Errorf("expected %q, got %v", expected, messages)
+	}
+	if logged := output.String(); strings.Contains(logged, "c0ns0le-t0ken") {
+		t.Errorf("log output contains the console password:\n%s", logged)
+	} else if !strings.Contains(logged, "console_token") {
+		t.Errorf("log output missing the API response:\n%s", logged)
+	}
+}
+
+func TestServerSnapshotNowActionError(t *testing.T) {
+	client := newFakeAPIClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusConflict)
+		w.Write([]byte(`{"error_name":"invalid_state","errors":["Server is busy"]}`))
+	})
+	diags, _ := invokeAction(t, newServerSnapshotNowAction(), client, map[string]tftypes.Value{
+		"server": tftypes.NewValue(tftypes.String, "srv-abcde"),
+	})
+	if !diags.HasError() {
+		t.Fatal("expected an error")
+	}
+}
+
+func TestServerActionInvalidTimeout(t *testing.T) {
+	client := newFakeAPIClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+	})
+	diags, _ := invokeAction(t, newServerRebootAction(), client, map[string]tftypes.Value{
+		"server":  tftypes.NewValue(tftypes.String, "srv-abcde"),
+		"timeout": tftypes.NewValue(tftypes.String, "soon"),
+	})
+	if !diags.HasError() {
+		t.Fatal("expected an error")
+	}
+}
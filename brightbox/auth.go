@@ -3,7 +3,6 @@ package brightbox
 import (
 	"context"
 	"fmt"
-	"log"
 	"net/http"
 
 	brightbox "github.com/brightbox/gobrightbox/v2"
@@ -13,58 +12,65 @@ import (
 	"github.com/brightbox/gobrightbox/v2/passwordcredentials"
 	"github.com/gophercloud/gophercloud"
 	"github.com/hashicorp/go-cleanhttp"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/logging"
 	"golang.org/x/oauth2"
 )
 
-func authenticatedClients(authCtx context.Context, authd authdetails) (*brightbox.Client, *gophercloud.ServiceClient, diag.Diagnostics) {
+func authenticatedClients(authCtx context.Context, authd *authdetails) (*brightbox.Client, *gophercloud.ServiceClient, *brightbox.Account, diag.Diagnostics) {
 	apiContext, apiCancel := context.WithCancel(context.Background())
 	defer apiCancel()
-	apiContext = contextWithLoggedHTTPClient(apiContext)
+	apiContext = contextWithLoggedHTTPClient(apiContext, apiSubsystem)
 
-	log.Printf("[DEBUG] Fetching Infrastructure Client")
-	client, err := brightbox.Connect(apiContext, confFromAuthd(authd))
+	tflog.Debug(authCtx, "Fetching Infrastructure Client")
+	client, err := brightbox.Connect(apiContext, confFromAuthd(*authd))
 	if err != nil {
-		return nil, nil, brightboxFromErrSlice(err)
+		return nil, nil, nil, brightboxFromErrSlice(err)
 	}
 
 	var diags diag.Diagnostics
+	var account *brightbox.Account
 
 	if authd.Account == "" {
-		log.Printf("[INFO] Obtaining default account")
+		tflog.Info(authCtx, "Obtaining default account")
 
 		accounts, err := client.Accounts(authCtx)
 		if err != nil {
-			return nil, nil, brightboxFromErrSlice(err)
+			return nil, nil, nil, brightboxFromErrSlice(err)
 		}
-		authd.Account = accounts[0].ID
-		log.Printf("[DEBUG] default account is %v", authd.Account)
-		diags = checkIsActive(diags, &accounts[0])
+		account = &accounts[0]
+		authd.Account = account.ID
+		tflog.Debug(authCtx, "Default account found", map[string]interface{}{
+			logFieldAccount: authd.Account,
+		})
+		diags = checkIsActive(diags, account)
 	} else {
-		log.Printf("[INFO] Checking credentials have access to %v", authd.Account)
-		account, err := client.Account(authCtx, authd.Account)
+		tflog.Info(authCtx, "Checking credentials have access to account", map[string]interface{}{
+			logFieldAccount: authd.Account,
+		})
+		account, err = client.Account(authCtx, authd.Account)
 		if err != nil {
-			return nil, nil, diag.Errorf("Unable to access account %v with supplied credentials", authd.Account)
+			return nil, nil, nil, diag.Errorf("Unable to access account %v with supplied credentials", authd.Account)
 		}
-		log.Printf("[DEBUG] account check passsed")
+		tflog.Debug(authCtx, "Account check passed")
 		diags = checkIsActive(diags, account)
 	}
 
-	log.Printf("[DEBUG] Building Orbit Client")
-	oe, err := orbitEndpointFromAuthd(authd)
+	tflog.Debug(authCtx, "Building Orbit Client")
+	oe, err := orbitEndpointFromAuthd(*authd)
 	if err != nil {
-		return nil, nil, append(diags, brightboxFromErr(err))
+		return nil, nil, nil, append(diags, brightboxFromErr(err))
 	}
 
 	storageContext, storageCancel := context.WithCancel(context.Background())
 	defer storageCancel()
-	storageContext = contextWithLoggedHTTPClient(storageContext)
+	storageContext = contextWithLoggedHTTPClient(storageContext, orbitSubsystem)
 	orbit, err := orbitServiceClient(storageContext, client, oe)
 	if err != nil {
 		diags = append(diags, brightboxFromErr(err))
 	}
-	return client, orbit, diags
+	return client, orbit, account, diags
 }
 
 func checkIsActive(diags diag.Diagnostics, account *brightbox.Account) diag.Diagnostics {
@@ -126,16 +132,22 @@ func confFromAuthd(authd authdetails) brightbox.Oauth2 {
 		Secret: authd.APISecret,
 		Config: endpoint.Config{
 			BaseURL: authd.APIURL,
+			Account: authd.Account,
 			Scopes:  endpoint.FullScope,
 		},
 	}
 }
 
-func contextWithLoggedHTTPClient(ctx context.Context) context.Context {
+// contextWithLoggedHTTPClient adds an HTTP client to the context that
+// logs requests and responses to the given subsystem of the logger
+// carried by each request's context, traces them within the
+// operation's span and captures the request IDs of failures
+func contextWithLoggedHTTPClient(ctx context.Context, subsystem string) context.Context {
 	client := cleanhttp.DefaultClient()
-	if logging.IsDebugOrHigher() {
-		log.Printf("[DEBUG] Enabling HTTP requests/responses tracing")
-		client.Transport = logging.NewTransport("Brightbox", client.Transport)
-	}
+	client.Transport = newRequestIDTransport(
+		newTracingTransport(
+			logging.NewSubsystemLoggingHTTPTransport(subsystem, client.Transport),
+		),
+	)
 	return context.WithValue(ctx, oauth2.HTTPClient, client)
 }
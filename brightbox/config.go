@@ -2,12 +2,13 @@ package brightbox
 
 import (
 	"context"
-	"log"
 	"os"
 	"strings"
+	"sync"
 
 	brightbox "github.com/brightbox/gobrightbox/v2"
 	"github.com/gophercloud/gophercloud"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 )
 
@@ -30,6 +31,12 @@ const (
 type CompositeClient struct {
 	APIClient   *brightbox.Client
 	OrbitClient *gophercloud.ServiceClient
+	Account     string
+
+	authd    authdetails
+	details  *brightbox.Account
+	mutex    sync.Mutex
+	accounts map[string]*CompositeClient
 }
 
 type authdetails struct {
@@ -45,7 +52,6 @@ type authdetails struct {
 // obtainCloudClient creates a new Composite client using details from
 // the environment
 func obtainCloudClient() (*CompositeClient, diag.Diagnostics) {
-	log.Printf("[DEBUG] obtainCloudClient")
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 	return configureClient(
@@ -65,11 +71,11 @@ func obtainCloudClient() (*CompositeClient, diag.Diagnostics) {
 }
 
 // Validate account config entries
-func validateConfig(authd authdetails) diag.Diagnostics {
+func validateConfig(ctx context.Context, authd authdetails) diag.Diagnostics {
 	var result diag.Diagnostics
-	log.Printf("[DEBUG] Validating Config")
+	tflog.Debug(ctx, "Validating Config")
 	if strings.HasPrefix(authd.APIClient, appPrefix) {
-		log.Printf("[DEBUG] Detected OAuth Application. Validating User details.")
+		tflog.Debug(ctx, "Detected OAuth Application. Validating User details.")
 		if authd.UserName == "" || authd.password == "" {
 			result = append(result, diag.Errorf("User Credentials are missing. Please supply a Username and One Time Authentication code")...)
 		}
@@ -77,7 +83,7 @@ func validateConfig(authd authdetails) diag.Diagnostics {
 			result = append(result, diag.Errorf("Must specify Account with User Credentials")...)
 		}
 	} else {
-		log.Printf("[DEBUG] Detected API Client.")
+		tflog.Debug(ctx, "Detected API Client.")
 		if authd.UserName != "" || authd.password != "" {
 			result = append(result, diag.Errorf("User Credentials should be blank with an API Client")...)
 		}
@@ -86,24 +92,80 @@ func validateConfig(authd authdetails) diag.Diagnostics {
 }
 
 func configureClient(ctx context.Context, authd authdetails) (*CompositeClient, diag.Diagnostics) {
-	log.Printf("[DEBUG] Configuring Brightbox Clients")
-	if err := validateConfig(authd); err.HasError() {
+	tflog.Debug(ctx, "Configuring Brightbox Clients")
+	if err := validateConfig(ctx, authd); err.HasError() {
 		return nil, err
 	}
 
-	apiclient, orbitclient, diags := authenticatedClients(ctx, authd)
+	apiclient, orbitclient, account, diags := authenticatedClients(ctx, &authd)
 
 	if apiclient != nil {
-		log.Printf("[INFO] Brightbox Client configured for URL: %s", apiclient.ResourceBaseURL())
+		tflog.Info(ctx, "Brightbox Client configured", map[string]interface{}{
+			"url": apiclient.ResourceBaseURL().String(),
+		})
 	}
 	if orbitclient != nil {
-		log.Printf("[INFO] Orbit Client configured for URL: %s", orbitclient.ResourceBaseURL())
+		tflog.Info(ctx, "Orbit Client configured", map[string]interface{}{
+			"url": orbitclient.ResourceBaseURL(),
+		})
 	}
 
 	composite := &CompositeClient{
 		APIClient:   apiclient,
 		OrbitClient: orbitclient,
+		Account:     authd.Account,
+		authd:       authd,
+		details:     account,
 	}
 
 	return composite, diags
 }
+
+var (
+	sharedClientsMutex sync.Mutex
+	sharedClients      = map[authdetails]*CompositeClient{}
+)
+
+// configureSharedClient returns the client configured with authd,
+// configuring it on first use, so the SDKv2 and plugin framework halves
+// of the provider authenticate once between them
+func configureSharedClient(ctx context.Context, authd authdetails) (*CompositeClient, diag.Diagnostics) {
+	sharedClientsMutex.Lock()
+	defer sharedClientsMutex.Unlock()
+	if client, ok := sharedClients[authd]; ok {
+		return client, nil
+	}
+	client, diags := configureClient(ctx, authd)
+	if !diags.HasError() {
+		sharedClients[authd] = client
+	}
+	return client, diags
+}
+
+// ForAccount returns a Composite client operating upon the given
+// account. Clients for accounts other than the provider account are
+// built from the same credentials on first use and cached thereafter.
+func (c *CompositeClient) ForAccount(ctx context.Context, account string) (*CompositeClient, diag.Diagnostics) {
+	if account == "" || account == c.Account {
+		return c, nil
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if client, ok := c.accounts[account]; ok {
+		return client, nil
+	}
+	tflog.Info(ctx, "Configuring Brightbox Clients for account", map[string]interface{}{
+		logFieldAccount: account,
+	})
+	authd := c.authd
+	authd.Account = account
+	client, diags := configureClient(ctx, authd)
+	if diags.HasError() {
+		return nil, diags
+	}
+	if c.accounts == nil {
+		c.accounts = make(map[string]*CompositeClient)
+	}
+	c.accounts[account] = client
+	return client, diags
+}
@@ -0,0 +1,157 @@
+package brightbox
+
+import (
+	"context"
+
+	brightbox "github.com/brightbox/gobrightbox/v2"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceBrightboxAccount() *schema.Resource {
+	return &schema.Resource{
+		Description: "Brightbox Account",
+		ReadContext: dataSourceBrightboxAccountRead,
+
+		Schema: map[string]*schema.Schema{
+
+			"block_storage_limit": {
+				Description: "Block storage limit in megabytes",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+
+			"block_storage_used": {
+				Description: "Block storage in use in megabytes",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+
+			"cloud_ips_limit": {
+				Description: "Maximum number of Cloud IPs",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+
+			"cloud_ips_used": {
+				Description: "Number of Cloud IPs in use",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+
+			"dbs_instances_used": {
+				Description: "Number of database servers in use",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+
+			"dbs_ram_limit": {
+				Description: "Database server RAM limit in megabytes",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+
+			"dbs_ram_used": {
+				Description: "Database server RAM in use in megabytes",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+
+			"load_balancers_limit": {
+				Description: "Maximum number of load balancers",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+
+			"load_balancers_used": {
+				Description: "Number of load balancers in use",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+
+			"name": {
+				Description: "Name of the account",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+
+			"ram_limit": {
+				Description: "Server RAM limit in megabytes",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+
+			"ram_used": {
+				Description: "Server RAM in use in megabytes",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+
+			"servers_used": {
+				Description: "Number of servers in use",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+
+			"status": {
+				Description: "Current state of the account",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// dataSourceBrightboxAccountRead uses the account details obtained
+// when the client was configured, only asking the API if there are none
+func dataSourceBrightboxAccountRead(
+	ctx context.Context,
+	d *schema.ResourceData,
+	meta interface{},
+) diag.Diagnostics {
+	client := meta.(*CompositeClient)
+	account := client.details
+	if account == nil {
+		tflog.Debug(ctx, "Retrieving account details", map[string]interface{}{
+			logFieldAccount: client.Account,
+		})
+		var err error
+		account, err = client.APIClient.Account(ctx, client.Account)
+		if err != nil {
+			return brightboxFromErrSlice(err)
+		}
+	}
+	return setAccountAttributes(d, account)
+}
+
+func setAccountAttributes(
+	d *schema.ResourceData,
+	account *brightbox.Account,
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	d.SetId(account.ID)
+	attributes := map[string]interface{}{
+		"name":                 account.Name,
+		"status":               account.Status.String(),
+		"servers_used":         account.ServersUsed,
+		"ram_limit":            account.RAMLimit,
+		"ram_used":             account.RAMUsed,
+		"dbs_instances_used":   account.DbsInstancesUsed,
+		"dbs_ram_limit":        account.DbsRAMLimit,
+		"dbs_ram_used":         account.DbsRAMUsed,
+		"block_storage_limit":  account.BlockStorageLimit,
+		"block_storage_used":   account.BlockStorageUsed,
+		"cloud_ips_limit":      account.CloudIPsLimit,
+		"cloud_ips_used":       account.CloudIPsUsed,
+		"load_balancers_limit": account.LoadBalancersLimit,
+		"load_balancers_used":  account.LoadBalancersUsed,
+	}
+	for key, value := range attributes {
+		if err := d.Set(key, value); err != nil {
+			diags = append(diags, diag.Errorf("unexpected: %s", err)...)
+		}
+	}
+	return diags
+}
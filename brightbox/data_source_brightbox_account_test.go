@@ -0,0 +1,77 @@
+package brightbox
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	brightbox "github.com/brightbox/gobrightbox/v2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccBrightboxDataAccount_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config: TestAccBrightboxDataAccountConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBrightboxDataSourceID("Account", "data.brightbox_account.current"),
+					resource.TestMatchResourceAttr(
+						"data.brightbox_account.current", "id", accountRegexp),
+					resource.TestCheckResourceAttrPair(
+						"data.brightbox_account.current", "account",
+						"data.brightbox_account.current", "id"),
+					resource.TestCheckResourceAttr(
+						"data.brightbox_account.current", "status", "active"),
+					resource.TestCheckResourceAttrSet(
+						"data.brightbox_account.current", "ram_limit"),
+				),
+			},
+		},
+	})
+}
+
+const TestAccBrightboxDataAccountConfig_basic = `
+data "brightbox_account" "current" {
+}
+`
+
+func TestAccountDetailsFromConfiguredClient(t *testing.T) {
+	var calls int
+	client := newFakeAPIClient(t, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id":"acc-12345","name":"Fetched","status":"active","ram_limit":8192}`))
+	})
+	resource := dataSourceBrightboxAccount()
+
+	cached := &CompositeClient{
+		APIClient: client,
+		Account:   "acc-12345",
+		details:   &brightbox.Account{ID: "acc-12345", Name: "Cached", RAMLimit: 4096, RAMUsed: 1024},
+	}
+	d := resource.TestResourceData()
+	if diags := dataSourceBrightboxAccountRead(context.Background(), d, cached); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if calls != 0 {
+		t.Errorf("expected the configured account details to be used, got %d API calls", calls)
+	}
+	if d.Id() != "acc-12345" || d.Get("name") != "Cached" || d.Get("ram_used") != 1024 {
+		t.Errorf("unexpected account attributes %#v", d.State())
+	}
+
+	fetched := &CompositeClient{APIClient: client, Account: "acc-12345"}
+	d = resource.TestResourceData()
+	if diags := dataSourceBrightboxAccountRead(context.Background(), d, fetched); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if calls != 1 {
+		t.Errorf("expected the account to be fetched, got %d API calls", calls)
+	}
+	if d.Get("name") != "Fetched" || d.Get("ram_limit") != 8192 {
+		t.Errorf("unexpected account attributes %#v", d.State())
+	}
+}
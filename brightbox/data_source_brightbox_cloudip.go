@@ -0,0 +1,163 @@
+package brightbox
+
+import (
+	"net"
+	"regexp"
+
+	brightbox "github.com/brightbox/gobrightbox/v2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceBrightboxCloudIP() *schema.Resource {
+	return &schema.Resource{
+		Description: "Brightbox Cloud IP",
+		ReadContext: datasourceBrightboxRead(
+			(*brightbox.Client).CloudIPs,
+			"Cloud IP",
+			setCloudIPAttributes,
+			findCloudIPFunc,
+		),
+
+		Schema: map[string]*schema.Schema{
+
+			"fqdn": {
+				Description: "Full Domain name entry for the Cloud IP",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+
+			"id": {
+				Description:  "The ID of the Cloud IP to find",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringMatch(cloudIPRegexp, "must be a valid Cloud IP ID"),
+			},
+
+			"name": {
+				Description:  "A regex to match against the Cloud IP name",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+
+			"port_translator": {
+				Description: "Array of Port Translators",
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"incoming": {
+							Description: "Incoming Port",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"outgoing": {
+							Description: "Outgoing Port",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"protocol": {
+							Description: "Transport protocol to port translate (tcp/udp)",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+				Set: resourceBrightboxPortTranslationHash,
+			},
+
+			"public_ip": {
+				Description: "Old alias of the IPv4 address",
+				Deprecated:  "Use `public_ipv4` instead",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+
+			"public_ipv4": {
+				Description:  "IPv4 address",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IsIPv4Address,
+			},
+
+			"public_ipv6": {
+				Description:  "IPv6 address",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IsIPv6Address,
+			},
+
+			"reverse_dns": {
+				Description:  "Reverse DNS entry for the Cloud IP",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringMatch(dnsNameRegexp, "must be a valid DNS name"),
+			},
+
+			"status": {
+				Description: "Current state of the Cloud IP",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+
+			"target": {
+				Description: "The object this Cloud IP maps to",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func findCloudIPFunc(
+	d *schema.ResourceData,
+) (func(brightbox.CloudIP) bool, diag.Diagnostics) {
+	var nameRe *regexp.Regexp
+	var err error
+	var diags diag.Diagnostics
+	id := d.Get("id").(string)
+	reverseDNS := d.Get("reverse_dns").(string)
+	if temp, ok := d.GetOk("name"); ok {
+		if nameRe, err = regexp.Compile(temp.(string)); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+	}
+	addressMatch := cloudIPAddressMatcher(
+		net.ParseIP(d.Get("public_ipv4").(string)),
+		net.ParseIP(d.Get("public_ipv6").(string)),
+	)
+
+	return func(object brightbox.CloudIP) bool {
+		if id != "" && object.ID != id {
+			return false
+		}
+		if nameRe != nil && !nameRe.MatchString(object.Name) {
+			return false
+		}
+		if reverseDNS != "" && object.ReverseDNS != reverseDNS {
+			return false
+		}
+		return addressMatch(object)
+	}, diags
+}
+
+// cloudIPAddressMatcher selects Cloud IPs with the given public
+// addresses. A nil address matches anything.
+func cloudIPAddressMatcher(ipv4 net.IP, ipv6 net.IP) func(brightbox.CloudIP) bool {
+	return func(object brightbox.CloudIP) bool {
+		if ipv4 != nil && !ipv4.Equal(net.ParseIP(object.PublicIPv4)) {
+			return false
+		}
+		if ipv6 != nil && !ipv6.Equal(net.ParseIP(object.PublicIPv6)) {
+			return false
+		}
+		return true
+	}
+}
@@ -0,0 +1,106 @@
+package brightbox
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccBrightboxDataCloudIP_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders(),
+		CheckDestroy:      testAccCheckBrightboxCloudIPDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckBrightboxDataCloudIPConfig_basic(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBrightboxDataSourceID("Cloud IP", "data.brightbox_cloudip.by_ipv4"),
+					resource.TestCheckResourceAttrPair(
+						"data.brightbox_cloudip.by_ipv4", "id",
+						"brightbox_cloudip.foobar", "id"),
+					resource.TestCheckResourceAttrPair(
+						"data.brightbox_cloudip.by_ipv4", "status",
+						"brightbox_cloudip.foobar", "status"),
+					resource.TestCheckResourceAttr(
+						"data.brightbox_cloudip.by_ipv4", "port_translator.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.brightbox_cloudip.by_ipv6", "id",
+						"brightbox_cloudip.foobar", "id"),
+					resource.TestCheckResourceAttrPair(
+						"data.brightbox_cloudip.by_name", "public_ipv4",
+						"brightbox_cloudip.foobar", "public_ipv4"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckBrightboxDataCloudIPConfig_basic(rInt int) string {
+	return fmt.Sprintf(`
+resource "brightbox_cloudip" "foobar" {
+	name = "foo-%d"
+	port_translator {
+		protocol = "tcp"
+		incoming = 2222
+		outgoing = 22
+	}
+}
+
+data "brightbox_cloudip" "by_ipv4" {
+	public_ipv4 = brightbox_cloudip.foobar.public_ipv4
+}
+
+data "brightbox_cloudip" "by_ipv6" {
+	public_ipv6 = brightbox_cloudip.foobar.public_ipv6
+}
+
+data "brightbox_cloudip" "by_name" {
+	name = "^${brightbox_cloudip.foobar.name}$"
+}
+`, rInt)
+}
+
+func TestCloudIPImportByAddress(t *testing.T) {
+	client := newFakeAPIClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[
+			{"id":"cip-aaaaa","public_ipv4":"109.107.35.1","public_ipv6":"2a02:1348:ffff:ffff::6d6b:2301"},
+			{"id":"cip-bbbbb","public_ipv4":"109.107.35.2","public_ipv6":"2a02:1348:ffff:ffff::6d6b:2302"}
+		]`))
+	})
+	meta := &CompositeClient{APIClient: client}
+	testCases := []struct {
+		in  string
+		out string
+		err bool
+	}{
+		{"cip-ccccc", "cip-ccccc", false},
+		{"109.107.35.2", "cip-bbbbb", false},
+		{"2a02:1348:ffff:ffff:0:0:6d6b:2301", "cip-aaaaa", false},
+		{"109.107.35.3", "", true},
+	}
+	for _, tcase := range testCases {
+		d := resourceBrightboxCloudIP().TestResourceData()
+		d.SetId(tcase.in)
+		result, err := resourceBrightboxCloudIPImport(context.Background(), d, meta)
+		if tcase.err {
+			if err == nil {
+				t.Errorf("%s: expected an error", tcase.in)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", tcase.in, err)
+			continue
+		}
+		if result[0].Id() != tcase.out {
+			t.Errorf("%s: expected %s, got %s", tcase.in, tcase.out, result[0].Id())
+		}
+	}
+}
@@ -0,0 +1,189 @@
+package brightbox
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// The engine Cloud SQL uses when none is requested
+const defaultDatabaseEngine = "mysql"
+
+// staticDatabaseEngineVersions is the catalogue of engines and versions
+// Cloud SQL accepted when this provider was released. The API does not
+// publish one, so it is maintained here, changes only with a provider
+// release and must be kept in step with validDatabaseEngines.
+var staticDatabaseEngineVersions = map[string][]string{
+	"mysql":      {"5.7", "8.0"},
+	"postgresql": {"12", "13", "14", "15", "16"},
+}
+
+func dataSourceBrightboxDatabaseEngines() *schema.Resource {
+	return &schema.Resource{
+		Description: "Brightbox Cloud SQL database engines and versions known to this release of the provider. The list is static: it is not read from the API and only changes with a provider release",
+		ReadContext: dataSourceBrightboxDatabaseEnginesRead,
+
+		Schema: map[string]*schema.Schema{
+
+			"engine": {
+				Description: "Only list this database engine",
+				Type:        schema.TypeString,
+				Optional:    true,
+				ValidateFunc: validation.StringInSlice(
+					validDatabaseEngines,
+					false,
+				),
+			},
+
+			"engines": {
+				Description: "The supported database engines",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"engine": {
+							Description: "Name of the database engine",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"latest": {
+							Description: "The newest version of the engine known to this release of the provider",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"versions": {
+							Description: "The versions of the engine known to this release of the provider, oldest first",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+
+			"latest": {
+				Description: "The newest version of the selected engine known to this release of the provider",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceBrightboxDatabaseEnginesRead(
+	ctx context.Context,
+	d *schema.ResourceData,
+	meta interface{},
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var err error
+
+	engines := validDatabaseEngines
+	if engine, ok := d.GetOk("engine"); ok {
+		engines = []string{engine.(string)}
+	}
+	var ids []string
+	flattened := make([]interface{}, len(engines))
+	for i, engine := range engines {
+		versions := sortedDatabaseVersions(engine)
+		for _, v := range versions {
+			ids = append(ids, engine+"-"+v)
+		}
+		flattened[i] = map[string]interface{}{
+			"engine":   engine,
+			"latest":   latestDatabaseVersion(engine),
+			"versions": versions,
+		}
+	}
+	d.SetId(listID(ids))
+	err = d.Set("engines", flattened)
+	if err != nil {
+		diags = append(diags, diag.Errorf("unexpected: %s", err)...)
+	}
+	latest := ""
+	if len(engines) == 1 {
+		latest = latestDatabaseVersion(engines[0])
+	}
+	err = d.Set("latest", latest)
+	if err != nil {
+		diags = append(diags, diag.Errorf("unexpected: %s", err)...)
+	}
+	return diags
+}
+
+// sortedDatabaseVersions returns the supported versions of engine,
+// oldest first
+func sortedDatabaseVersions(engine string) []string {
+	versions := append([]string(nil), staticDatabaseEngineVersions[engine]...)
+	sort.SliceStable(versions, func(i, j int) bool {
+		return version.Must(version.NewVersion(versions[i])).LessThan(
+			version.Must(version.NewVersion(versions[j])),
+		)
+	})
+	return versions
+}
+
+// latestDatabaseVersion returns the newest supported version of engine
+func latestDatabaseVersion(engine string) string {
+	versions := sortedDatabaseVersions(engine)
+	if len(versions) == 0 {
+		return ""
+	}
+	return versions[len(versions)-1]
+}
+
+// validateDatabaseEngineVersion warns when the configured engine and
+// version are missing from the catalogue. The API does not publish its
+// supported versions, so a newer version may still be accepted and is
+// sent unchanged.
+func validateDatabaseEngineVersion(
+	_ context.Context,
+	req schema.ValidateResourceConfigFuncRequest,
+	resp *schema.ValidateResourceConfigFuncResponse,
+) {
+	rawConfig := req.RawConfig
+	if !rawConfig.IsKnown() || rawConfig.IsNull() {
+		return
+	}
+	requested := rawConfig.GetAttr("database_version")
+	rawEngine := rawConfig.GetAttr("database_engine")
+	if !requested.IsKnown() || requested.IsNull() || !rawEngine.IsKnown() {
+		return
+	}
+	engine := defaultDatabaseEngine
+	if !rawEngine.IsNull() {
+		engine = rawEngine.AsString()
+	}
+	if err := checkDatabaseEngineVersion(engine, requested.AsString()); err != nil {
+		resp.Diagnostics = append(resp.Diagnostics, diag.Diagnostic{
+			Severity:      diag.Warning,
+			Summary:       "Unknown database version",
+			Detail:        err.Error() + ". It is sent to the API unchanged, which may reject it.",
+			AttributePath: cty.GetAttrPath("database_version"),
+		})
+	}
+}
+
+// checkDatabaseEngineVersion returns an error listing the known
+// versions if requested is not one of those of engine
+func checkDatabaseEngineVersion(engine string, requested string) error {
+	versions := sortedDatabaseVersions(engine)
+	for _, v := range versions {
+		if v == requested {
+			return nil
+		}
+	}
+	return fmt.Errorf(
+		"database_version %q is not a known version of %s. Known versions are %s",
+		requested, engine, strings.Join(versions, ", "),
+	)
+}
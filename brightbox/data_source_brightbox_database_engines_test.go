@@ -0,0 +1,133 @@
+package brightbox
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccBrightboxDatabaseEngines_postgresql(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config: TestAccBrightboxDatabaseEnginesConfig_postgresql,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBrightboxDataSourceID("Database Engines", "data.brightbox_database_engines.pg"),
+					resource.TestCheckResourceAttr(
+						"data.brightbox_database_engines.pg", "engines.#", "1"),
+					resource.TestCheckResourceAttr(
+						"data.brightbox_database_engines.pg", "engines.0.engine", "postgresql"),
+					resource.TestCheckResourceAttr(
+						"data.brightbox_database_engines.pg", "latest", latestDatabaseVersion("postgresql")),
+				),
+			},
+		},
+	})
+}
+
+const TestAccBrightboxDatabaseEnginesConfig_postgresql = `
+data "brightbox_database_engines" "pg" {
+	engine = "postgresql"
+}
+`
+
+func TestDatabaseEngineCatalogue(t *testing.T) {
+	engines := make([]string, 0, len(staticDatabaseEngineVersions))
+	for engine := range staticDatabaseEngineVersions {
+		engines = append(engines, engine)
+	}
+	sort.Strings(engines)
+	if strings.Join(engines, ",") != strings.Join(validDatabaseEngines, ",") {
+		t.Errorf("catalogue engines %v do not match valid engines %v", engines, validDatabaseEngines)
+	}
+	for _, engine := range engines {
+		if latestDatabaseVersion(engine) == "" {
+			t.Errorf("%s has no versions", engine)
+		}
+	}
+}
+
+func TestLatestDatabaseVersion(t *testing.T) {
+	saved := staticDatabaseEngineVersions
+	defer func() { staticDatabaseEngineVersions = saved }()
+	staticDatabaseEngineVersions = map[string][]string{
+		"postgresql": {"9.6", "14", "10"},
+	}
+	if result := latestDatabaseVersion("postgresql"); result != "14" {
+		t.Errorf("expected 14, got %s", result)
+	}
+	if result := strings.Join(sortedDatabaseVersions("postgresql"), ","); result != "9.6,10,14" {
+		t.Errorf("expected 9.6,10,14, got %s", result)
+	}
+	if result := latestDatabaseVersion("oracle"); result != "" {
+		t.Errorf("expected no version, got %s", result)
+	}
+}
+
+func TestCheckDatabaseEngineVersion(t *testing.T) {
+	testCases := []struct {
+		engine  string
+		version string
+		valid   bool
+	}{
+		{"mysql", "8.0", true},
+		{"mysql", "14", false},
+		{"postgresql", "14", true},
+		{"postgresql", "8.0", false},
+		{"postgresql", "latest", false},
+		{"oracle", "19", false},
+	}
+	for _, tcase := range testCases {
+		err := checkDatabaseEngineVersion(tcase.engine, tcase.version)
+		if tcase.valid && err != nil {
+			t.Errorf("%s %s: unexpected error: %s", tcase.engine, tcase.version, err)
+		}
+		if !tcase.valid && err == nil {
+			t.Errorf("%s %s: expected an error", tcase.engine, tcase.version)
+		}
+	}
+}
+
+func TestValidateDatabaseEngineVersionWarns(t *testing.T) {
+	server := resourceBrightboxDatabaseServer()
+	testCases := []struct {
+		engine  cty.Value
+		version cty.Value
+		warns   bool
+	}{
+		{cty.StringVal("mysql"), cty.StringVal("8.0"), false},
+		{cty.NullVal(cty.String), cty.StringVal("8.0"), false},
+		{cty.StringVal("mysql"), cty.StringVal("5.6"), true},
+		{cty.StringVal("postgresql"), cty.StringVal("99"), true},
+		{cty.StringVal("postgresql"), cty.UnknownVal(cty.String), false},
+		{cty.UnknownVal(cty.String), cty.StringVal("99"), false},
+		{cty.StringVal("mysql"), cty.NullVal(cty.String), false},
+	}
+	for _, tcase := range testCases {
+		attributes := make(map[string]cty.Value)
+		for name, attributeType := range server.CoreConfigSchema().ImpliedType().AttributeTypes() {
+			attributes[name] = cty.NullVal(attributeType)
+		}
+		attributes["database_engine"] = tcase.engine
+		attributes["database_version"] = tcase.version
+		resp := &schema.ValidateResourceConfigFuncResponse{}
+		validateDatabaseEngineVersion(
+			context.Background(),
+			schema.ValidateResourceConfigFuncRequest{RawConfig: cty.ObjectVal(attributes)},
+			resp,
+		)
+		if resp.Diagnostics.HasError() {
+			t.Errorf("%#v %#v: expected no error, got %v", tcase.engine, tcase.version, resp.Diagnostics)
+		}
+		if warns := len(resp.Diagnostics) > 0; warns != tcase.warns {
+			t.Errorf("%#v %#v: expected warning %t, got %v", tcase.engine, tcase.version, tcase.warns, resp.Diagnostics)
+		}
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	brightbox "github.com/brightbox/gobrightbox/v2"
+	"github.com/brightbox/gobrightbox/v2/enums/databasesnapshotstatus"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -69,10 +70,19 @@ func dataSourceBrightboxDatabaseSnapshot() *schema.Resource {
 			},
 
 			"name": {
-				Description: "Editable user label",
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
+				Description:  "Editable user label. When set, a regex to match against the snapshot name",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Deprecated:   "Use name_regex instead",
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+
+			"name_regex": {
+				Description:  "A regex to match against the snapshot name",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
 			},
 
 			"size": {
@@ -81,10 +91,23 @@ func dataSourceBrightboxDatabaseSnapshot() *schema.Resource {
 				Computed:    true,
 			},
 
+			"source_database_server": {
+				Description:  "ID of the database server the snapshot was taken from",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringMatch(databaseServerRegexp, "must be a valid database server ID"),
+			},
+
 			"status": {
 				Description: "Snapshot state",
 				Type:        schema.TypeString,
+				Optional:    true,
 				Computed:    true,
+				ValidateFunc: validation.StringInSlice(
+					databasesnapshotstatus.ValidStrings,
+					false,
+				),
 			},
 		},
 	}
@@ -118,6 +141,10 @@ func dataSourceBrightboxDatabaseSnapshotsImageAttributes(
 	if err != nil {
 		diags = append(diags, diag.Errorf("unexpected: %s", err)...)
 	}
+	err = d.Set("source_database_server", image.Source)
+	if err != nil {
+		diags = append(diags, diag.Errorf("unexpected: %s", err)...)
+	}
 	err = d.Set("size", image.Size)
 	if err != nil {
 		diags = append(diags, diag.Errorf("unexpected: %s", err)...)
@@ -137,28 +164,46 @@ func dataSourceBrightboxDatabaseSnapshotsImageAttributes(
 func findDatabaseSnapshotFunc(
 	d *schema.ResourceData,
 ) (func(brightbox.DatabaseSnapshot) bool, diag.Diagnostics) {
-	var nameRe, descRe *regexp.Regexp
+	var descRe *regexp.Regexp
 	var err error
 	var diags diag.Diagnostics
-	if temp, ok := d.GetOk("name"); ok {
-		if nameRe, err = regexp.Compile(temp.(string)); err != nil {
-			diags = append(diags, diag.FromErr(err)...)
-		}
-	}
-
+	nameRe, errs := optionalRegexp(d, "name_regex")
+	diags = append(diags, errs...)
+	// name was a regex before name_regex was added
+	legacyNameRe, errs := optionalRegexp(d, "name")
+	diags = append(diags, errs...)
 	if temp, ok := d.GetOk("description"); ok {
 		if descRe, err = regexp.Compile(temp.(string)); err != nil {
 			diags = append(diags, diag.FromErr(err)...)
 		}
 	}
+	engine, engineok := d.GetOk("database_engine")
+	version, versionok := d.GetOk("database_version")
+	source, sourceok := d.GetOk("source_database_server")
+	status, statusok := d.GetOk("status")
 
 	return func(object brightbox.DatabaseSnapshot) bool {
+		if legacyNameRe != nil && !legacyNameRe.MatchString(object.Name) {
+			return false
+		}
 		if nameRe != nil && !nameRe.MatchString(object.Name) {
 			return false
 		}
 		if descRe != nil && !descRe.MatchString(object.Description) {
 			return false
 		}
+		if engineok && engine.(string) != object.DatabaseEngine {
+			return false
+		}
+		if versionok && version.(string) != object.DatabaseVersion {
+			return false
+		}
+		if sourceok && source.(string) != object.Source {
+			return false
+		}
+		if statusok && status.(string) != object.Status.String() {
+			return false
+		}
 		return true
 	}, diags
 }
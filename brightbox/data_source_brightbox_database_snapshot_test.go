@@ -0,0 +1,111 @@
+package brightbox
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	brightbox "github.com/brightbox/gobrightbox/v2"
+	"github.com/brightbox/gobrightbox/v2/enums/databasesnapshotstatus"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func databaseSnapshotFixture(
+	id string,
+	name string,
+	source string,
+	status databasesnapshotstatus.Enum,
+	created string,
+) brightbox.DatabaseSnapshot {
+	createdAt, _ := time.Parse(time.RFC3339, created)
+	return brightbox.DatabaseSnapshot{
+		ID:              id,
+		Name:            name,
+		Source:          source,
+		Status:          status,
+		DatabaseEngine:  "mysql",
+		DatabaseVersion: "8.0",
+		CreatedAt:       &createdAt,
+	}
+}
+
+var databaseSnapshotFixtures = []brightbox.DatabaseSnapshot{
+	databaseSnapshotFixture("dbi-aaaaa", "nightly", "dbs-11111", databasesnapshotstatus.Available, "2024-09-01T00:00:00Z"),
+	databaseSnapshotFixture("dbi-bbbbb", "nightly", "dbs-22222", databasesnapshotstatus.Available, "2024-09-03T00:00:00Z"),
+	databaseSnapshotFixture("dbi-ccccc", "nightly", "dbs-11111", databasesnapshotstatus.Available, "2024-09-02T00:00:00Z"),
+	databaseSnapshotFixture("dbi-ddddd", "nightly", "dbs-11111", databasesnapshotstatus.Creating, "2024-09-04T00:00:00Z"),
+	databaseSnapshotFixture("dbi-eeeee", "pre-upgrade", "dbs-11111", databasesnapshotstatus.Available, "2024-08-01T00:00:00Z"),
+}
+
+func TestDatabaseSnapshots(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		config   map[string]interface{}
+		expected []string
+	}{
+		{
+			"all newest first",
+			map[string]interface{}{},
+			[]string{"dbi-ddddd", "dbi-bbbbb", "dbi-ccccc", "dbi-aaaaa", "dbi-eeeee"},
+		},
+		{
+			"source database server",
+			map[string]interface{}{"source_database_server": "dbs-11111"},
+			[]string{"dbi-ddddd", "dbi-ccccc", "dbi-aaaaa", "dbi-eeeee"},
+		},
+		{
+			"available from source",
+			map[string]interface{}{"source_database_server": "dbs-11111", "status": "available"},
+			[]string{"dbi-ccccc", "dbi-aaaaa", "dbi-eeeee"},
+		},
+		{
+			"deprecated name is still a regex",
+			map[string]interface{}{"name": "^pre-"},
+			[]string{"dbi-eeeee"},
+		},
+		{
+			"name regex",
+			map[string]interface{}{"name_regex": "^night", "source_database_server": "dbs-22222"},
+			[]string{"dbi-bbbbb"},
+		},
+		{
+			"engine version",
+			map[string]interface{}{"database_engine": "postgresql"},
+			[]string{},
+		},
+	}
+	for _, tcase := range testCases {
+		t.Run(tcase.desc, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, dataSourceBrightboxDatabaseSnapshots().Schema, tcase.config)
+			matcher, diags := findDatabaseSnapshotFunc(d)
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+			results := filter(databaseSnapshotFixtures, matcher)
+			if diags := setDatabaseSnapshotsAttributes(d, results); diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+			result := d.Get("ids").([]interface{})
+			if fmt.Sprint(result) != fmt.Sprint(tcase.expected) {
+				t.Errorf("expected %v, got %v", tcase.expected, result)
+			}
+			if len(result) > 0 && d.Get("database_snapshots.0.id") != result[0] {
+				t.Errorf("expected first snapshot to be %v", result[0])
+			}
+		})
+	}
+}
+
+func TestDatabaseSnapshotMostRecentOfServer(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, dataSourceBrightboxDatabaseSnapshot().Schema, map[string]interface{}{
+		"source_database_server": "dbs-11111",
+		"name_regex":             "nightly",
+		"status":                 "available",
+		"most_recent":            true,
+	})
+	matcher, _ := findDatabaseSnapshotFunc(d)
+	results := filter(databaseSnapshotFixtures, matcher)
+	if result := mostRecent(results); result.ID != "dbi-ccccc" {
+		t.Errorf("expected dbi-ccccc, got %s", result.ID)
+	}
+}
@@ -0,0 +1,190 @@
+package brightbox
+
+import (
+	"sort"
+	"time"
+
+	brightbox "github.com/brightbox/gobrightbox/v2"
+	"github.com/brightbox/gobrightbox/v2/enums/databasesnapshotstatus"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceBrightboxDatabaseSnapshots() *schema.Resource {
+	return &schema.Resource{
+		Description: "Brightbox Database Snapshots",
+		ReadContext: datasourceBrightboxListRead(
+			(*brightbox.Client).DatabaseSnapshots,
+			"Database Snapshot",
+			setDatabaseSnapshotsAttributes,
+			findDatabaseSnapshotFunc,
+		),
+
+		Schema: map[string]*schema.Schema{
+
+			"database_engine": {
+				Description: "Only match snapshots of this database engine",
+				Type:        schema.TypeString,
+				Optional:    true,
+				ValidateFunc: validation.StringInSlice(
+					validDatabaseEngines,
+					false,
+				),
+			},
+
+			"database_snapshots": {
+				Description: "The matching snapshots, newest first",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"created_at": {
+							Description: "Time of resource creation (UTC)",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"database_engine": {
+							Description: "The engine of the database used to create this snapshot",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"database_version": {
+							Description: "The version of the database engine used to create this snapshot",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"description": {
+							Description: "Editable user label",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"id": {
+							Description: "The ID of the snapshot",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"locked": {
+							Description: "True if snapshot is locked and cannot be deleted",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+						"name": {
+							Description: "Editable user label",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"size": {
+							Description: "Size of database partition in megabytes",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"source_database_server": {
+							Description: "ID of the database server the snapshot was taken from",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"status": {
+							Description: "Snapshot state",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+
+			"database_version": {
+				Description:  "Only match snapshots of this database engine version",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+
+			"description": {
+				Description:  "A regex to match against the snapshot descriptions",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+
+			"ids": {
+				Description: "IDs of the matching snapshots, newest first",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"name": {
+				Description:  "A regex to match against the snapshot names",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Deprecated:   "Use name_regex instead",
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+
+			"name_regex": {
+				Description:  "A regex to match against the snapshot names",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+
+			"source_database_server": {
+				Description:  "Only match snapshots taken from this database server",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringMatch(databaseServerRegexp, "must be a valid database server ID"),
+			},
+
+			"status": {
+				Description: "Only match snapshots in this state",
+				Type:        schema.TypeString,
+				Optional:    true,
+				ValidateFunc: validation.StringInSlice(
+					databasesnapshotstatus.ValidStrings,
+					false,
+				),
+			},
+		},
+	}
+}
+
+func setDatabaseSnapshotsAttributes(
+	d *schema.ResourceData,
+	snapshots []brightbox.DatabaseSnapshot,
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var err error
+
+	sort.SliceStable(snapshots, func(i, j int) bool {
+		return snapshots[i].CreatedAtUnix() > snapshots[j].CreatedAtUnix()
+	})
+	ids := idList(snapshots, func(v brightbox.DatabaseSnapshot) string { return v.ID })
+	d.SetId(listID(ids))
+	err = d.Set("ids", ids)
+	if err != nil {
+		diags = append(diags, diag.Errorf("unexpected: %s", err)...)
+	}
+	flattened := make([]interface{}, len(snapshots))
+	for i, snapshot := range snapshots {
+		flattened[i] = map[string]interface{}{
+			"id":                     snapshot.ID,
+			"name":                   snapshot.Name,
+			"description":            snapshot.Description,
+			"status":                 snapshot.Status.String(),
+			"database_engine":        snapshot.DatabaseEngine,
+			"database_version":       snapshot.DatabaseVersion,
+			"source_database_server": snapshot.Source,
+			"size":                   snapshot.Size,
+			"created_at":             snapshot.CreatedAt.Format(time.RFC3339),
+			"locked":                 snapshot.Locked,
+		}
+	}
+	err = d.Set("database_snapshots", flattened)
+	if err != nil {
+		diags = append(diags, diag.Errorf("unexpected: %s", err)...)
+	}
+	return diags
+}
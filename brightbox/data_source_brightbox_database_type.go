@@ -6,16 +6,18 @@ import (
 	brightbox "github.com/brightbox/gobrightbox/v2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func dataSourceBrightboxDatabaseType() *schema.Resource {
 	return &schema.Resource{
 		Description: "Brightbox Cloud SQL Database Type",
-		ReadContext: datasourceBrightboxRead(
+		ReadContext: datasourceBrightboxSmallestRead(
 			(*brightbox.Client).DatabaseServerTypes,
 			"Database Type",
 			dataSourceBrightboxDatabaseTypesAttributes,
 			findDatabaseServerTypeFunc,
+			databaseServerTypeLess,
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -39,6 +41,20 @@ func dataSourceBrightboxDatabaseType() *schema.Resource {
 				Computed:    true,
 			},
 
+			"min_disk_size": {
+				Description:  "Only match database types with at least this disk size in megabytes",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+
+			"min_ram": {
+				Description:  "Only match database types with at least this RAM size in megabytes",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+
 			"name": {
 				Description: "Name of this database type",
 				Type:        schema.TypeString,
@@ -46,6 +62,16 @@ func dataSourceBrightboxDatabaseType() *schema.Resource {
 				Computed:    true,
 			},
 
+			"select": {
+				Description: "How to choose between several matching types: `smallest` picks the one with the least RAM, then disk",
+				Type:        schema.TypeString,
+				Optional:    true,
+				ValidateFunc: validation.StringInSlice(
+					validSelectModes,
+					false,
+				),
+			},
+
 			"ram": {
 				Description: "RAM size in megabytes",
 				Type:        schema.TypeInt,
@@ -84,7 +110,7 @@ func dataSourceBrightboxDatabaseTypesAttributes(
 		diags = append(diags, diag.Errorf("unexpected: %s", err)...)
 	}
 
-	return nil
+	return diags
 }
 
 func findDatabaseServerTypeFunc(
@@ -105,6 +131,9 @@ func findDatabaseServerTypeFunc(
 		}
 	}
 
+	minRAM := uint(d.Get("min_ram").(int))
+	minDiskSize := uint(d.Get("min_disk_size").(int))
+
 	return func(object brightbox.DatabaseServerType) bool {
 		if nameRe != nil && !nameRe.MatchString(object.Name) {
 			return false
@@ -112,6 +141,18 @@ func findDatabaseServerTypeFunc(
 		if descRe != nil && !descRe.MatchString(object.Description) {
 			return false
 		}
+		if object.RAM < minRAM || object.DiskSize < minDiskSize {
+			return false
+		}
 		return true
 	}, diags
 }
+
+// databaseServerTypeLess orders database types by RAM, then disk size,
+// which follows their price
+func databaseServerTypeLess(a, b brightbox.DatabaseServerType) bool {
+	if a.RAM != b.RAM {
+		return a.RAM < b.RAM
+	}
+	return a.DiskSize < b.DiskSize
+}
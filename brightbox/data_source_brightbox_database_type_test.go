@@ -3,7 +3,9 @@ package brightbox
 import (
 	"testing"
 
+	brightbox "github.com/brightbox/gobrightbox/v2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func TestAccBrightboxDatabaseType_basic(t *testing.T) {
@@ -27,8 +29,69 @@ func TestAccBrightboxDatabaseType_basic(t *testing.T) {
 	})
 }
 
+func TestAccBrightboxDatabaseType_smallest(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config: TestAccBrightboxDatabaseTypeConfig_smallest,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBrightboxDataSourceID("Database Type", "data.brightbox_database_type.foobar"),
+					resource.TestCheckResourceAttr(
+						"data.brightbox_database_type.foobar", "name", "SSD 4GB"),
+					resource.TestCheckResourceAttr(
+						"data.brightbox_database_type.foobar", "ram", "4096"),
+				),
+			},
+		},
+	})
+}
+
+const TestAccBrightboxDatabaseTypeConfig_smallest = `
+data "brightbox_database_type" "foobar" {
+	min_ram = 3072
+	select = "smallest"
+}
+`
+
 const TestAccBrightboxDatabaseTypeConfig_basic = `
 data "brightbox_database_type" "foobar" {
 	name = "^SSD 4GB$"
 }
 `
+
+func TestSmallestDatabaseType(t *testing.T) {
+	fixtures := []brightbox.DatabaseServerType{
+		{ID: "dbt-8gbss", Name: "SSD 8GB", RAM: 8192, DiskSize: 122880},
+		{ID: "dbt-4gbbg", Name: "SSD 4GB Large", RAM: 4096, DiskSize: 122880},
+		{ID: "dbt-4gbss", Name: "SSD 4GB", RAM: 4096, DiskSize: 61440},
+		{ID: "dbt-2gbss", Name: "SSD 2GB", RAM: 2048, DiskSize: 30720},
+	}
+	testCases := []struct {
+		desc     string
+		config   map[string]interface{}
+		expected string
+	}{
+		{"no minimums", map[string]interface{}{}, "dbt-2gbss"},
+		{"minimum ram", map[string]interface{}{"min_ram": 3072}, "dbt-4gbss"},
+		{"minimum disk", map[string]interface{}{"min_disk_size": 65536}, "dbt-4gbbg"},
+		{"name", map[string]interface{}{"name": "^SSD 8"}, "dbt-8gbss"},
+	}
+	for _, tcase := range testCases {
+		t.Run(tcase.desc, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, dataSourceBrightboxDatabaseType().Schema, tcase.config)
+			matcher, diags := findDatabaseServerTypeFunc(d)
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+			results := filter(fixtures, matcher)
+			if len(results) == 0 {
+				t.Fatalf("expected %s, got no matches", tcase.expected)
+			}
+			if result := smallest(results, databaseServerTypeLess); result.ID != tcase.expected {
+				t.Errorf("expected %s, got %s", tcase.expected, result.ID)
+			}
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package brightbox
+
+import (
+	"regexp"
+
+	brightbox "github.com/brightbox/gobrightbox/v2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceBrightboxFirewallPolicy() *schema.Resource {
+	return &schema.Resource{
+		Description: "Brightbox Firewall Policy",
+		ReadContext: datasourceBrightboxRead(
+			(*brightbox.Client).FirewallPolicies,
+			"Firewall Policy",
+			setFirewallPolicyAttributes,
+			findFirewallPolicyFunc,
+		),
+
+		Schema: map[string]*schema.Schema{
+
+			"description": {
+				Description: "Description of the policy",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+
+			"id": {
+				Description:  "The ID of the firewall policy to find",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringMatch(firewallPolicyRegexp, "must be a valid firewall policy ID"),
+			},
+
+			"name": {
+				Description:  "A regex to match against the policy name",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+
+			"server_group": {
+				Description: "The server group using this policy",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func findFirewallPolicyFunc(
+	d *schema.ResourceData,
+) (func(brightbox.FirewallPolicy) bool, diag.Diagnostics) {
+	var nameRe *regexp.Regexp
+	var err error
+	var diags diag.Diagnostics
+	id := d.Get("id").(string)
+	if temp, ok := d.GetOk("name"); ok {
+		if nameRe, err = regexp.Compile(temp.(string)); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+	}
+
+	return func(object brightbox.FirewallPolicy) bool {
+		if id != "" && object.ID != id {
+			return false
+		}
+		if nameRe != nil && !nameRe.MatchString(object.Name) {
+			return false
+		}
+		return true
+	}, diags
+}
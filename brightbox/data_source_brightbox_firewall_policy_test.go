@@ -0,0 +1,48 @@
+package brightbox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccBrightboxDataFirewallPolicy_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders(),
+		CheckDestroy:      testAccCheckBrightboxFirewallPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckBrightboxDataFirewallPolicyConfig_basic(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBrightboxDataSourceID("Firewall Policy", "data.brightbox_firewall_policy.by_name"),
+					resource.TestCheckResourceAttrPair(
+						"data.brightbox_firewall_policy.by_name", "id",
+						"brightbox_firewall_policy.foobar", "id"),
+					resource.TestCheckResourceAttr(
+						"data.brightbox_firewall_policy.by_name", "description", fmt.Sprintf("foo-%d", rInt)),
+					resource.TestCheckResourceAttrPair(
+						"data.brightbox_firewall_policy.by_id", "name",
+						"brightbox_firewall_policy.foobar", "name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckBrightboxDataFirewallPolicyConfig_basic(rInt int) string {
+	return fmt.Sprintf(`
+%s
+
+data "brightbox_firewall_policy" "by_name" {
+	name = "^${brightbox_firewall_policy.foobar.name}$"
+}
+
+data "brightbox_firewall_policy" "by_id" {
+	id = brightbox_firewall_policy.foobar.id
+}
+`, testAccCheckBrightboxFirewallPolicyConfig_basic(rInt))
+}
@@ -71,12 +71,33 @@ func dataSourceBrightboxImage() *schema.Resource {
 				Computed:    true,
 			},
 
+			"description_regex": {
+				Description:  "A regex to match against the image description",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+
 			"disk_size": {
 				Description: "The actual size of the data within this image in Megabytes",
 				Type:        schema.TypeInt,
 				Computed:    true,
 			},
 
+			"exclude_regex": {
+				Description:  "A regex to exclude images by name",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+
+			"include_deprecated": {
+				Description: "Also match deprecated images",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+
 			"licence_name": {
 				Description: "The licence name for this image",
 				Type:        schema.TypeString,
@@ -107,10 +128,19 @@ func dataSourceBrightboxImage() *schema.Resource {
 			},
 
 			"name": {
-				Description: "User Label for this image",
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
+				Description:  "User Label for this image. When set, a regex to match against the image name",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Deprecated:   "Use name_regex instead",
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+
+			"name_regex": {
+				Description:  "A regex to match against the image name",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
 			},
 
 			"official": {
@@ -285,20 +315,17 @@ func dataSourceBrightboxImagesImageAttributes(
 func findImageFunc(
 	d *schema.ResourceData,
 ) (func(brightbox.Image) bool, diag.Diagnostics) {
-	var nameRe, descRe *regexp.Regexp
-	var err error
 	var diags diag.Diagnostics
-	if temp, ok := d.GetOk("name"); ok {
-		if nameRe, err = regexp.Compile(temp.(string)); err != nil {
-			diags = append(diags, diag.FromErr(err)...)
-		}
-	}
-
-	if temp, ok := d.GetOk("handle"); ok {
-		if descRe, err = regexp.Compile(temp.(string)); err != nil {
-			diags = append(diags, diag.FromErr(err)...)
-		}
-	}
+	nameRe, errs := optionalRegexp(d, "name_regex")
+	diags = append(diags, errs...)
+	// name was a regex before name_regex was added
+	legacyNameRe, errs := optionalRegexp(d, "name")
+	diags = append(diags, errs...)
+	descRe, errs := optionalRegexp(d, "description_regex")
+	diags = append(diags, errs...)
+	excludeRe, errs := optionalRegexp(d, "exclude_regex")
+	diags = append(diags, errs...)
+	description, descriptionok := d.GetOk("description")
 	source, sourceok := d.GetOk("source")
 	sourceTrigger, sourceTriggerok := d.GetOk("source_trigger")
 	sourceType, sourceTypeok := d.GetOk("source_type")
@@ -312,14 +339,29 @@ func findImageFunc(
 	public, publicok := d.GetOk("public")
 	official, officialok := d.GetOk("official")
 	compat := d.Get("compatibility_mode")
+	// Asking for deprecated images by status implies including them
+	includeDeprecated := d.Get("include_deprecated").(bool) ||
+		(statusok && status.(string) == imagestatus.Deprecated.String())
 	return func(image brightbox.Image) bool {
 		// Only check available images
 		if !validImageStatusMap[image.Status] {
 			return false
 		}
+		if image.Status == imagestatus.Deprecated && !includeDeprecated {
+			return false
+		}
+		if legacyNameRe != nil && !legacyNameRe.MatchString(image.Name) {
+			return false
+		}
 		if nameRe != nil && !nameRe.MatchString(image.Name) {
 			return false
 		}
+		if excludeRe != nil && excludeRe.MatchString(image.Name) {
+			return false
+		}
+		if descriptionok && description.(string) != image.Description {
+			return false
+		}
 		if descRe != nil && !descRe.MatchString(image.Description) {
 			return false
 		}
@@ -353,7 +395,7 @@ func findImageFunc(
 			}
 		}
 		if image.MinRAM != nil {
-			if minRAMok && minRAM.(uint) != *image.MinRAM {
+			if minRAMok && uint(minRAM.(int)) != *image.MinRAM {
 				return false
 			}
 		}
@@ -372,3 +414,19 @@ func findImageFunc(
 		return true
 	}, diags
 }
+
+// optionalRegexp compiles the regex held in key, if it is set
+func optionalRegexp(
+	d *schema.ResourceData,
+	key string,
+) (*regexp.Regexp, diag.Diagnostics) {
+	temp, ok := d.GetOk(key)
+	if !ok {
+		return nil, nil
+	}
+	re, err := regexp.Compile(temp.(string))
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	return re, nil
+}
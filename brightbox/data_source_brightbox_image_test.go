@@ -5,7 +5,11 @@ import (
 	"regexp"
 	"testing"
 
+	brightbox "github.com/brightbox/gobrightbox/v2"
+	"github.com/brightbox/gobrightbox/v2/enums/arch"
+	"github.com/brightbox/gobrightbox/v2/enums/imagestatus"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 const latest = "jammy-22.04"
@@ -121,7 +125,7 @@ func TestAccBrightboxImageDataSource_ubuntu_latest_official(t *testing.T) {
 
 const TestAccBrightboxImageDataSourceConfig_blank_disk = `
 data "brightbox_image" "foobar" {
-	name = "^Blank Disk Image$"
+	name = "Blank Disk Image"
 	arch = "x86_64"
 	official = true
 }
@@ -131,9 +135,137 @@ data "brightbox_image" "foobar" {
 // Checks name matches partial name
 var TestAccBrightboxImageDataSourceConfig_ubuntu_latest_official = fmt.Sprintf(`
 data "brightbox_image" "foobar" {
-	name = "^ubuntu-%s.*server"
+	name_regex = "^ubuntu-%s.*server"
 	arch = "x86_64"
 	official = true
 	most_recent = true
 }
 `, latest)
+
+var imageFilterFixtures = []brightbox.Image{
+	{
+		ID:          "img-avail",
+		Name:        "ubuntu-noble-24.04-amd64-server",
+		Description: "ID: com.ubuntu.cloud:server:24.04:amd64, Release: 20240901, disk1.img",
+		Status:      imagestatus.Available,
+		Arch:        arch.X86_64,
+		Official:    true,
+	},
+	{
+		ID:          "img-depre",
+		Name:        "ubuntu-noble-24.04-amd64-server",
+		Description: "ID: com.ubuntu.cloud:server:24.04:amd64, Release: 20240601, disk1.img",
+		Status:      imagestatus.Deprecated,
+		Arch:        arch.X86_64,
+		Official:    true,
+	},
+	{
+		ID:          "img-betaa",
+		Name:        "ubuntu-oracular-24.10-beta-amd64-server",
+		Description: "ID: com.ubuntu.cloud:server:24.10:amd64, Release: beta, disk1.img",
+		Status:      imagestatus.Available,
+		Arch:        arch.X86_64,
+		Official:    true,
+	},
+	{
+		ID:          "img-minim",
+		Name:        "ubuntu-noble-24.04-amd64-server-minimal",
+		Description: "ID: com.ubuntu.cloud:server:24.04:amd64, Release: 20240901, minimal.img",
+		Status:      imagestatus.Available,
+		Arch:        arch.X86_64,
+		Official:    true,
+	},
+	{
+		ID:     "img-blank",
+		Name:   "Blank Disk Image",
+		Status: imagestatus.Available,
+		Arch:   arch.X86_64,
+	},
+	{
+		ID:     "img-gonee",
+		Name:   "Blank Disk Image",
+		Status: imagestatus.Deleted,
+		Arch:   arch.X86_64,
+	},
+}
+
+func TestFindImageFunc(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		config   map[string]interface{}
+		expected []string
+	}{
+		{
+			"no filters skip deprecated and deleted images",
+			map[string]interface{}{},
+			[]string{"img-avail", "img-betaa", "img-minim", "img-blank"},
+		},
+		{
+			"deprecated name is still a regex",
+			map[string]interface{}{"name": "^ubuntu-noble"},
+			[]string{"img-avail", "img-minim"},
+		},
+		{
+			"anchored name including deprecated",
+			map[string]interface{}{
+				"name":               "^ubuntu-noble-24.04-amd64-server$",
+				"include_deprecated": true,
+			},
+			[]string{"img-avail", "img-depre"},
+		},
+		{
+			"deprecated status includes deprecated",
+			map[string]interface{}{"status": "deprecated"},
+			[]string{"img-depre"},
+		},
+		{
+			"name regex",
+			map[string]interface{}{"name_regex": "^ubuntu-noble"},
+			[]string{"img-avail", "img-minim"},
+		},
+		{
+			"name regex with exclusion",
+			map[string]interface{}{
+				"name_regex":    "^ubuntu-",
+				"exclude_regex": "-(beta|minimal)",
+			},
+			[]string{"img-avail"},
+		},
+		{
+			"description regex",
+			map[string]interface{}{"description_regex": "minimal\\.img$"},
+			[]string{"img-minim"},
+		},
+		{
+			"description matches exactly",
+			map[string]interface{}{
+				"description":        "ID: com.ubuntu.cloud:server:24.04:amd64, Release: 20240601, disk1.img",
+				"include_deprecated": true,
+			},
+			[]string{"img-depre"},
+		},
+		{
+			"official images",
+			map[string]interface{}{"official": true, "exclude_regex": "beta"},
+			[]string{"img-avail", "img-minim"},
+		},
+	}
+	for _, tcase := range testCases {
+		t.Run(tcase.desc, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, dataSourceBrightboxImage().Schema, tcase.config)
+			matcher, diags := findImageFunc(d)
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+			var result []string
+			for _, image := range imageFilterFixtures {
+				if matcher(image) {
+					result = append(result, image.ID)
+				}
+			}
+			if fmt.Sprint(result) != fmt.Sprint(tcase.expected) {
+				t.Errorf("expected %v, got %v", tcase.expected, result)
+			}
+		})
+	}
+}
@@ -0,0 +1,478 @@
+package brightbox
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	brightbox "github.com/brightbox/gobrightbox/v2"
+	"github.com/brightbox/gobrightbox/v2/enums/arch"
+	"github.com/brightbox/gobrightbox/v2/enums/imagestatus"
+	"github.com/brightbox/gobrightbox/v2/enums/sourcetrigger"
+	"github.com/brightbox/gobrightbox/v2/enums/sourcetype"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	imageSortCreatedAt = "created_at"
+	imageSortName      = "name"
+	imageSortVersion   = "version"
+)
+
+var (
+	validImageSortOrders = []string{imageSortCreatedAt, imageSortName, imageSortVersion}
+	// A dotted or plain number forming a whole word of an image name,
+	// e.g. "24.04" in "ubuntu-noble-24.04-amd64-server-20240901"
+	imageVersionRegexp = regexp.MustCompile(`^v?\d+(\.\d+)*$`)
+	// An eight digit build date, e.g. "20240901"
+	imageBuildRegexp = regexp.MustCompile(`^\d{8}$`)
+)
+
+func dataSourceBrightboxImages() *schema.Resource {
+	return &schema.Resource{
+		Description: "Brightbox Images",
+		ReadContext: datasourceBrightboxListRead(
+			(*brightbox.Client).Images,
+			"Image",
+			setImagesAttributes,
+			findImagesFunc,
+		),
+
+		Schema: map[string]*schema.Schema{
+
+			"ancestor_id": {
+				Description: "Only match images derived from this image",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+
+			"arch": {
+				Description: "Only match images with this OS Architecture",
+				Type:        schema.TypeString,
+				Optional:    true,
+				ValidateFunc: validation.StringInSlice(
+					arch.ValidStrings,
+					false,
+				),
+			},
+
+			"compatibility_mode": {
+				Description: "Match images requiring a non-virtio VM shell",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+
+			"description": {
+				Description: "Only match images with exactly this description",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+
+			"description_regex": {
+				Description:  "A regex to match against the image descriptions",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+
+			"exclude_regex": {
+				Description:  "A regex to exclude images by name",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+
+			"ids": {
+				Description: "IDs of the matching images in sort order",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"images": {
+				Description: "The matching images in sort order",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ancestor_id": {
+							Description: "Image this image was derived from",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"arch": {
+							Description: "OS Architecture",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"compatibility_mode": {
+							Description: "Does this image require a non-virtio VM shell",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+						"created_at": {
+							Description: "The time this image was created/registered (UTC)",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"description": {
+							Description: "A Description of the image",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"disk_size": {
+							Description: "The actual size of the data within this image in Megabytes",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"id": {
+							Description: "The ID of the image",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"licence_name": {
+							Description: "The licence name for this image",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"locked": {
+							Description: "Is true if the image is set as locked and cannot be deleted",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+						"min_ram": {
+							Description: "The minimum RAM required by this image in Megabytes",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"name": {
+							Description: "User Label for this image",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"official": {
+							Description: "Is this image an official Brightbox provided one?",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+						"owner": {
+							Description: "Account ID this image belongs to",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"public": {
+							Description: "Is this image available to other customers?",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+						"source": {
+							Description: "Name of the Source for this image",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"source_trigger": {
+							Description: "Source trigger for this image (manual or schedule)",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"source_type": {
+							Description: "Source type for this image (upload or snapshot)",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"status": {
+							Description: "State of the image",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"username": {
+							Description: "Username to use when logging into a server booted with this image",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"version": {
+							Description: "Version number found in the image name, if any",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"virtual_size": {
+							Description: "The virtual size of the disk image container in Megabytes",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+					},
+				},
+			},
+
+			"include_deprecated": {
+				Description: "Also match deprecated images",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+
+			"licence_name": {
+				Description: "Only match images with this licence name",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+
+			"min_ram": {
+				Description:  "Only match images with this minimum RAM in Megabytes",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+
+			"name": {
+				Description:  "A regex to match against the image names",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Deprecated:   "Use name_regex instead",
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+
+			"name_regex": {
+				Description:  "A regex to match against the image names",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+
+			"official": {
+				Description: "Only match official Brightbox images",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
+
+			"owner": {
+				Description: "Only match images belonging to this account",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+
+			"public": {
+				Description: "Only match images available to other customers",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
+
+			"sort_by": {
+				Description: "Order of the results: `created_at` (newest first), `version` (highest first) or `name`",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     imageSortCreatedAt,
+				ValidateFunc: validation.StringInSlice(
+					validImageSortOrders,
+					false,
+				),
+			},
+
+			"source": {
+				Description: "Only match images with this source",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+
+			"source_trigger": {
+				Description: "Only match images with this source trigger (manual or schedule)",
+				Type:        schema.TypeString,
+				Optional:    true,
+				ValidateFunc: validation.StringInSlice(
+					sourcetrigger.ValidStrings,
+					false,
+				),
+			},
+
+			"source_type": {
+				Description: "Only match images with this source type (upload or snapshot)",
+				Type:        schema.TypeString,
+				Optional:    true,
+				ValidateFunc: validation.StringInSlice(
+					sourcetype.ValidStrings,
+					false,
+				),
+			},
+
+			"status": {
+				Description: "Only match images in this state",
+				Type:        schema.TypeString,
+				Optional:    true,
+				ValidateFunc: validation.StringInSlice(
+					imagestatus.ValidStrings,
+					false,
+				),
+			},
+
+			"username": {
+				Description:  "Only match images with this login username",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+
+			"version_constraint": {
+				Description:  "Only match images with a version number in their name meeting this constraint, e.g. `>= 22.04, < 24.10`",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateVersionConstraint,
+			},
+		},
+	}
+}
+
+func validateVersionConstraint(v interface{}, name string) (warns []string, errors []error) {
+	if _, err := version.NewConstraint(v.(string)); err != nil {
+		errors = append(errors, err)
+	}
+	return
+}
+
+func findImagesFunc(
+	d *schema.ResourceData,
+) (func(brightbox.Image) bool, diag.Diagnostics) {
+	imageMatch, diags := findImageFunc(d)
+	constraintMatch, errs := imageVersionMatcher(d.Get("version_constraint").(string))
+	diags = append(diags, errs...)
+	return func(image brightbox.Image) bool {
+		return imageMatch(image) && constraintMatch(image)
+	}, diags
+}
+
+// imageVersionMatcher selects images with a version number in their
+// name meeting the constraint. An empty constraint matches anything.
+func imageVersionMatcher(constraint string) (func(brightbox.Image) bool, diag.Diagnostics) {
+	if constraint == "" {
+		return func(brightbox.Image) bool { return true }, nil
+	}
+	constraints, err := version.NewConstraint(constraint)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	return func(image brightbox.Image) bool {
+		imageVersion, _ := imageNameVersion(image.Name)
+		return imageVersion != nil && constraints.Check(imageVersion)
+	}, nil
+}
+
+// imageNameVersion extracts the version number and any build date
+// embedded in an image name
+func imageNameVersion(name string) (*version.Version, string) {
+	var result *version.Version
+	var build string
+	words := strings.FieldsFunc(name, func(r rune) bool {
+		return r == '-' || r == '_' || r == ' '
+	})
+	for _, word := range words {
+		switch {
+		case imageBuildRegexp.MatchString(word):
+			build = word
+		case result == nil && imageVersionRegexp.MatchString(word):
+			result, _ = version.NewVersion(word)
+		}
+	}
+	return result, build
+}
+
+func setImagesAttributes(
+	d *schema.ResourceData,
+	images []brightbox.Image,
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var err error
+
+	sortImages(images, d.Get("sort_by").(string))
+	ids := idList(images, func(v brightbox.Image) string { return v.ID })
+	d.SetId(listID(ids))
+	err = d.Set("ids", ids)
+	if err != nil {
+		diags = append(diags, diag.Errorf("unexpected: %s", err)...)
+	}
+	flattened := make([]interface{}, 0, len(images))
+	for i := range images {
+		flattened = append(flattened, flattenImage(&images[i]))
+	}
+	err = d.Set("images", flattened)
+	if err != nil {
+		diags = append(diags, diag.Errorf("unexpected: %s", err)...)
+	}
+	return diags
+}
+
+// sortImages orders images by name, by newest first or by highest
+// version first. Images sharing a version are ordered by build date
+// and then creation time, and images without a version come last.
+func sortImages(images []brightbox.Image, sortBy string) {
+	newer := func(i, j int) bool {
+		return images[i].CreatedAtUnix() > images[j].CreatedAtUnix()
+	}
+	switch sortBy {
+	case imageSortName:
+		sort.SliceStable(images, func(i, j int) bool {
+			return images[i].Name < images[j].Name
+		})
+	case imageSortVersion:
+		sort.SliceStable(images, func(i, j int) bool {
+			iVersion, iBuild := imageNameVersion(images[i].Name)
+			jVersion, jBuild := imageNameVersion(images[j].Name)
+			switch {
+			case iVersion == nil || jVersion == nil:
+				if iVersion != jVersion {
+					return jVersion == nil
+				}
+			case !iVersion.Equal(jVersion):
+				return iVersion.GreaterThan(jVersion)
+			}
+			if iBuild != jBuild {
+				return iBuild > jBuild
+			}
+			return newer(i, j)
+		})
+	default:
+		sort.SliceStable(images, newer)
+	}
+}
+
+// flattenImage maps an image onto the attribute names used by the
+// image data source
+func flattenImage(image *brightbox.Image) map[string]interface{} {
+	result := map[string]interface{}{
+		"id":                 image.ID,
+		"name":               image.Name,
+		"username":           image.Username,
+		"status":             image.Status.String(),
+		"locked":             image.Locked,
+		"description":        image.Description,
+		"arch":               image.Arch.String(),
+		"official":           image.Official,
+		"public":             image.Public,
+		"owner":              image.Owner,
+		"source":             image.Source,
+		"source_trigger":     image.SourceTrigger.String(),
+		"source_type":        image.SourceType.String(),
+		"virtual_size":       image.VirtualSize,
+		"disk_size":          image.DiskSize,
+		"compatibility_mode": image.CompatibilityMode,
+		"licence_name":       image.LicenceName,
+	}
+	if image.CreatedAt != nil {
+		result["created_at"] = image.CreatedAt.Format(time.RFC3339)
+	}
+	if image.MinRAM != nil {
+		result["min_ram"] = *image.MinRAM
+	}
+	if image.Ancestor != nil {
+		result["ancestor_id"] = image.Ancestor.ID
+	}
+	if imageVersion, _ := imageNameVersion(image.Name); imageVersion != nil {
+		result["version"] = imageVersion.Original()
+	}
+	return result
+}
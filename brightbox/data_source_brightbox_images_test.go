@@ -0,0 +1,130 @@
+package brightbox
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	brightbox "github.com/brightbox/gobrightbox/v2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccBrightboxImagesDataSource_ubuntu(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config: TestAccBrightboxImagesDataSourceConfig_ubuntu,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBrightboxDataSourceID("Images", "data.brightbox_images.ubuntu"),
+					resource.TestMatchResourceAttr(
+						"data.brightbox_images.ubuntu", "ids.0", imageRegexp),
+					resource.TestMatchResourceAttr(
+						"data.brightbox_images.ubuntu", "images.0.name", regexp.MustCompile(`^ubuntu-.*-2[2-9]\.04-`)),
+					resource.TestCheckResourceAttrSet(
+						"data.brightbox_images.ubuntu", "images.0.version"),
+					resource.TestCheckResourceAttr(
+						"data.brightbox_images.ubuntu", "images.0.official", "true"),
+				),
+			},
+		},
+	})
+}
+
+const TestAccBrightboxImagesDataSourceConfig_ubuntu = `
+data "brightbox_images" "ubuntu" {
+	name_regex = "^ubuntu-.*-server"
+	arch = "x86_64"
+	official = true
+	sort_by = "version"
+	version_constraint = ">= 22.04"
+}
+`
+
+func TestImageNameVersion(t *testing.T) {
+	testCases := []struct {
+		name    string
+		version string
+		build   string
+	}{
+		{"ubuntu-noble-24.04-amd64-server-20240901", "24.04", "20240901"},
+		{"ubuntu-jammy-22.04-amd64-server", "22.04", ""},
+		{"ubuntu-24.04-20240901", "24.04", "20240901"},
+		{"debian-12-amd64", "12", ""},
+		{"fedora_coreos_v40.1", "v40.1", ""},
+		{"Blank Disk Image", "", ""},
+		{"centos-stream-amd64", "", ""},
+	}
+	for _, tcase := range testCases {
+		result, build := imageNameVersion(tcase.name)
+		var original string
+		if result != nil {
+			original = result.Original()
+		}
+		if original != tcase.version || build != tcase.build {
+			t.Errorf("%q: expected (%q, %q), got (%q, %q)", tcase.name, tcase.version, tcase.build, original, build)
+		}
+	}
+}
+
+func imageFixture(id string, name string, created string) brightbox.Image {
+	createdAt, _ := time.Parse(time.RFC3339, created)
+	return brightbox.Image{ID: id, Name: name, CreatedAt: &createdAt}
+}
+
+func TestSortImages(t *testing.T) {
+	images := []brightbox.Image{
+		imageFixture("img-aaaaa", "ubuntu-jammy-22.04-amd64-server-20240101", "2024-01-01T00:00:00Z"),
+		imageFixture("img-bbbbb", "ubuntu-noble-24.04-amd64-server-20240601", "2024-06-01T00:00:00Z"),
+		imageFixture("img-ccccc", "Blank Disk Image", "2024-09-01T00:00:00Z"),
+		imageFixture("img-ddddd", "ubuntu-noble-24.04-amd64-server-20240901", "2024-09-01T00:00:00Z"),
+		imageFixture("img-eeeee", "ubuntu-jammy-22.04-amd64-server-20240801", "2024-08-01T00:00:00Z"),
+		imageFixture("img-fffff", "ubuntu-focal-20.04-amd64-server", "2020-04-01T00:00:00Z"),
+	}
+	testCases := []struct {
+		sortBy   string
+		expected []string
+	}{
+		{imageSortCreatedAt, []string{"img-ccccc", "img-ddddd", "img-eeeee", "img-bbbbb", "img-aaaaa", "img-fffff"}},
+		{imageSortVersion, []string{"img-ddddd", "img-bbbbb", "img-eeeee", "img-aaaaa", "img-fffff", "img-ccccc"}},
+		{imageSortName, []string{"img-ccccc", "img-fffff", "img-aaaaa", "img-eeeee", "img-bbbbb", "img-ddddd"}},
+	}
+	for _, tcase := range testCases {
+		sorted := append([]brightbox.Image(nil), images...)
+		sortImages(sorted, tcase.sortBy)
+		result := idList(sorted, func(v brightbox.Image) string { return v.ID })
+		if fmt.Sprint(result) != fmt.Sprint(tcase.expected) {
+			t.Errorf("sort by %s: expected %v, got %v", tcase.sortBy, tcase.expected, result)
+		}
+	}
+}
+
+func TestImageVersionMatcher(t *testing.T) {
+	testCases := []struct {
+		constraint string
+		name       string
+		match      bool
+	}{
+		{"", "Blank Disk Image", true},
+		{">= 22.04", "ubuntu-noble-24.04-amd64-server-20240901", true},
+		{">= 22.04", "ubuntu-focal-20.04-amd64-server", false},
+		{">= 22.04", "Blank Disk Image", false},
+		{"~> 24.04.0", "ubuntu-noble-24.04-amd64-server", true},
+		{">= 22.04, < 24.04", "ubuntu-noble-24.04-amd64-server", false},
+		{"= 12", "debian-12-amd64", true},
+	}
+	for _, tcase := range testCases {
+		matcher, diags := imageVersionMatcher(tcase.constraint)
+		if diags.HasError() {
+			t.Fatalf("%q: unexpected error: %v", tcase.constraint, diags)
+		}
+		if result := matcher(brightbox.Image{Name: tcase.name}); result != tcase.match {
+			t.Errorf("%q against %q: expected %v, got %v", tcase.constraint, tcase.name, tcase.match, result)
+		}
+	}
+	if _, diags := imageVersionMatcher("newest please"); !diags.HasError() {
+		t.Error("expected an error for an invalid constraint")
+	}
+}
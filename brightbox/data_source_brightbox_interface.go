@@ -0,0 +1,112 @@
+package brightbox
+
+import (
+	"strings"
+
+	brightbox "github.com/brightbox/gobrightbox/v2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceBrightboxInterface() *schema.Resource {
+	return &schema.Resource{
+		Description: "Brightbox Server Network Interface",
+		ReadContext: datasourceBrightboxRead(
+			(*brightbox.Client).Interfaces,
+			"Interface",
+			setInterfaceAttributes,
+			findInterfaceFunc,
+		),
+
+		Schema: map[string]*schema.Schema{
+
+			"id": {
+				Description:  "The ID of the interface to find",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringMatch(interfaceRegexp, "must be a valid interface ID"),
+			},
+
+			"ipv4_address": {
+				Description:  "Private IPv4 address of the interface",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IsIPv4Address,
+			},
+
+			"ipv6_address": {
+				Description: "Public IPv6 address of the interface",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+
+			"mac_address": {
+				Description:  "MAC address of the interface",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IsMACAddress,
+			},
+
+			"server": {
+				Description:  "ID of the server the interface is connected to",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringMatch(serverRegexp, "must be a valid server ID"),
+			},
+		},
+	}
+}
+
+func setInterfaceAttributes(
+	d *schema.ResourceData,
+	serverInterface *brightbox.Interface,
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	d.SetId(serverInterface.ID)
+	attributes := map[string]interface{}{
+		"ipv4_address": serverInterface.IPv4Address,
+		"ipv6_address": serverInterface.IPv6Address,
+		"mac_address":  serverInterface.MacAddress,
+		"server":       "",
+	}
+	if serverInterface.Server != nil {
+		attributes["server"] = serverInterface.Server.ID
+	}
+	for key, value := range attributes {
+		if err := d.Set(key, value); err != nil {
+			diags = append(diags, diag.Errorf("unexpected: %s", err)...)
+		}
+	}
+	return diags
+}
+
+func findInterfaceFunc(
+	d *schema.ResourceData,
+) (func(brightbox.Interface) bool, diag.Diagnostics) {
+	id := d.Get("id").(string)
+	server := d.Get("server").(string)
+	mac := d.Get("mac_address").(string)
+	ipv4 := d.Get("ipv4_address").(string)
+
+	return func(object brightbox.Interface) bool {
+		if id != "" && object.ID != id {
+			return false
+		}
+		if server != "" && (object.Server == nil || object.Server.ID != server) {
+			return false
+		}
+		if mac != "" && !strings.EqualFold(object.MacAddress, mac) {
+			return false
+		}
+		if ipv4 != "" && object.IPv4Address != ipv4 {
+			return false
+		}
+		return true
+	}, nil
+}
@@ -0,0 +1,140 @@
+package brightbox
+
+import (
+	"fmt"
+	"testing"
+
+	brightbox "github.com/brightbox/gobrightbox/v2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccBrightboxDataInterface_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders(),
+		CheckDestroy:      testAccCheckBrightboxServerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckBrightboxDataInterfaceConfig_basic(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBrightboxDataSourceID("Interface", "data.brightbox_interface.by_server"),
+					resource.TestCheckResourceAttrPair(
+						"data.brightbox_interface.by_server", "id",
+						"brightbox_server.foobar", "interface"),
+					resource.TestCheckResourceAttrPair(
+						"data.brightbox_interface.by_server", "id",
+						"brightbox_server.foobar", "interfaces.0.id"),
+					resource.TestCheckResourceAttrPair(
+						"data.brightbox_interface.by_server", "mac_address",
+						"brightbox_server.foobar", "interfaces.0.mac_address"),
+					resource.TestCheckResourceAttrPair(
+						"data.brightbox_interface.by_address", "id",
+						"brightbox_server.foobar", "interface"),
+					resource.TestCheckResourceAttrPair(
+						"data.brightbox_interface.by_mac", "server",
+						"brightbox_server.foobar", "id"),
+					resource.TestCheckResourceAttr(
+						"brightbox_server.foobar", "interfaces.#", "1"),
+					resource.TestCheckResourceAttr(
+						"brightbox_server.foobar", "cloud_ips.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckBrightboxDataInterfaceConfig_basic(rInt int) string {
+	return fmt.Sprintf(`
+resource "brightbox_server" "foobar" {
+	image = data.brightbox_image.foobar.id
+	name = "foo-%d"
+	type = "1gb.ssd"
+}
+
+data "brightbox_interface" "by_server" {
+	server = brightbox_server.foobar.id
+}
+
+data "brightbox_interface" "by_address" {
+	ipv4_address = brightbox_server.foobar.ipv4_address_private
+}
+
+data "brightbox_interface" "by_mac" {
+	mac_address = upper(brightbox_server.foobar.interfaces[0].mac_address)
+}
+
+%s`, rInt, TestAccBrightboxImageDataSourceConfig_blank_disk)
+}
+
+func TestFindInterfaceFunc(t *testing.T) {
+	interfaces := []brightbox.Interface{
+		{
+			ID:          "int-aaaaa",
+			MacAddress:  "02:24:19:00:00:01",
+			IPv4Address: "10.0.0.1",
+			Server:      &brightbox.Server{ID: "srv-aaaaa"},
+		},
+		{
+			ID:          "int-bbbbb",
+			MacAddress:  "02:24:19:00:00:02",
+			IPv4Address: "10.0.0.2",
+			Server:      &brightbox.Server{ID: "srv-bbbbb"},
+		},
+		{
+			ID:          "int-ccccc",
+			MacAddress:  "02:24:19:00:00:03",
+			IPv4Address: "10.0.0.3",
+		},
+	}
+	testCases := []struct {
+		config   map[string]interface{}
+		expected []string
+	}{
+		{map[string]interface{}{}, []string{"int-aaaaa", "int-bbbbb", "int-ccccc"}},
+		{map[string]interface{}{"id": "int-bbbbb"}, []string{"int-bbbbb"}},
+		{map[string]interface{}{"server": "srv-aaaaa"}, []string{"int-aaaaa"}},
+		{map[string]interface{}{"mac_address": "02:24:19:00:00:02"}, []string{"int-bbbbb"}},
+		{map[string]interface{}{"mac_address": "02:24:19:00:00:0A"}, nil},
+		{map[string]interface{}{"ipv4_address": "10.0.0.3"}, []string{"int-ccccc"}},
+		{map[string]interface{}{"ipv4_address": "10.0.0.3", "server": "srv-aaaaa"}, nil},
+	}
+	for _, tcase := range testCases {
+		d := schema.TestResourceDataRaw(t, dataSourceBrightboxInterface().Schema, tcase.config)
+		matcher, diags := findInterfaceFunc(d)
+		if diags.HasError() {
+			t.Fatalf("unexpected error: %v", diags)
+		}
+		result := idList(filter(interfaces, matcher), func(v brightbox.Interface) string { return v.ID })
+		if fmt.Sprint(result) != fmt.Sprint(tcase.expected) {
+			t.Errorf("%v: expected %v, got %v", tcase.config, tcase.expected, result)
+		}
+	}
+}
+
+func TestFlattenServerCloudIPs(t *testing.T) {
+	server := &brightbox.Server{
+		Interfaces: []brightbox.Interface{
+			{ID: "int-aaaaa", MacAddress: "02:24:19:00:00:01"},
+			{ID: "int-bbbbb", MacAddress: "02:24:19:00:00:02"},
+		},
+		CloudIPs: []brightbox.CloudIP{
+			{ID: "cip-aaaaa", PublicIPv4: "109.107.35.1", Interface: &brightbox.Interface{ID: "int-aaaaa"}},
+			{ID: "cip-bbbbb", PublicIPv4: "109.107.35.2", Interface: &brightbox.Interface{ID: "int-bbbbb"}},
+		},
+	}
+	interfaces := flattenServerInterfaces(server)
+	if len(interfaces) != 2 || interfaces[1].(map[string]interface{})["mac_address"] != "02:24:19:00:00:02" {
+		t.Errorf("unexpected interfaces: %v", interfaces)
+	}
+	cloudIPs := flattenServerCloudIPs(server)
+	if len(cloudIPs) != 2 {
+		t.Fatalf("expected 2 cloud IPs, got %v", cloudIPs)
+	}
+	second := cloudIPs[1].(map[string]interface{})
+	if second["public_ipv4"] != "109.107.35.2" || second["interface"] != "int-bbbbb" {
+		t.Errorf("unexpected cloud IP: %v", second)
+	}
+}
@@ -0,0 +1,224 @@
+package brightbox
+
+import (
+	"regexp"
+
+	brightbox "github.com/brightbox/gobrightbox/v2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceBrightboxLoadBalancer() *schema.Resource {
+	return &schema.Resource{
+		Description: "Brightbox Load Balancer",
+		ReadContext: datasourceBrightboxRead(
+			(*brightbox.Client).LoadBalancers,
+			"Load Balancer",
+			setLoadBalancerAttributes,
+			findLoadBalancerFunc,
+		),
+
+		Schema: map[string]*schema.Schema{
+
+			"buffer_size": {
+				Description: "Buffer size in bytes",
+				Type:        schema.TypeInt,
+				Deprecated:  "No longer supported. Buffer size is automatically calculated",
+				Computed:    true,
+			},
+
+			"domains": {
+				Description: "Array of domain names registered with ACME",
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Set:         schema.HashString,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"healthcheck": {
+				Description: "Healthcheck options",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+
+						"interval": {
+							Description: "How often to check in milliseconds",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+
+						"port": {
+							Description: "Port on server to connect to for healthcheck",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+
+						"request": {
+							Description: "HTTP path to check if http type healthcheck",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+
+						"threshold_down": {
+							Description: "How many checks have to fail before the load balancers considers a server inactive",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+
+						"threshold_up": {
+							Description: "How many checks have to pass before the load balancer considers the server active",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+
+						"timeout": {
+							Description: "How long to wait for a response before marking the check as a fail",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+
+						"type": {
+							Description: "Protocol type to check (tcp/http)",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+
+			"https_redirect": {
+				Description: "Redirect any requests on port 80 automatically to port 443",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+
+			"id": {
+				Description:  "The ID of the load balancer to find",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringMatch(loadBalancerRegexp, "must be a valid load balancer ID"),
+			},
+
+			"listener": {
+				Description: "Array of listeners",
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"in": {
+							Description: "The port this listener listens on",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+
+						"out": {
+							Description: "The port on this server the listener should talk to",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+
+						"protocol": {
+							Description: "The protocol to load balance (http/tcp)",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+
+						"proxy_protocol": {
+							Description: "The version of the Proxy Protocol supported by the backend servers",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+
+						"timeout": {
+							Description: "Connection timeout in milliseconds",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+					},
+				},
+				Set: resourceBrightboxLbListenerHash,
+			},
+
+			"locked": {
+				Description: "Is true if resource has been set as locked and can not be deleted",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+
+			"name": {
+				Description:  "A regex to match against the load balancer name",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+
+			"nodes": {
+				Description: "IDs of servers connected to this load balancer",
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Set: schema.HashString,
+			},
+
+			"policy": {
+				Description: "Method of load balancing",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+
+			"ssl_minimum_version": {
+				Description: "The minimum TLS/SSL version for the load balancer to accept",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+
+			"sslv3": {
+				Description: "Allow SSLv3 to be used",
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Deprecated:  "No longer supported. Will always return false",
+			},
+
+			"status": {
+				Description: "Current state of the load balancer",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func findLoadBalancerFunc(
+	d *schema.ResourceData,
+) (func(brightbox.LoadBalancer) bool, diag.Diagnostics) {
+	var nameRe *regexp.Regexp
+	var err error
+	var diags diag.Diagnostics
+	id := d.Get("id").(string)
+	if temp, ok := d.GetOk("name"); ok {
+		if nameRe, err = regexp.Compile(temp.(string)); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+	}
+
+	return func(object brightbox.LoadBalancer) bool {
+		if loadBalancerUnavailable(&object) {
+			return false
+		}
+		if id != "" && object.ID != id {
+			return false
+		}
+		if nameRe != nil && !nameRe.MatchString(object.Name) {
+			return false
+		}
+		return true
+	}, diags
+}
@@ -0,0 +1,61 @@
+package brightbox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccBrightboxDataLoadBalancer_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders(),
+		CheckDestroy:      testAccCheckBrightboxLoadBalancerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckBrightboxDataLoadBalancerConfig_basic(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBrightboxDataSourceID("Load Balancer", "data.brightbox_load_balancer.by_name"),
+					resource.TestCheckResourceAttrPair(
+						"data.brightbox_load_balancer.by_name", "id",
+						"brightbox_load_balancer.foobar", "id"),
+					resource.TestCheckResourceAttr(
+						"data.brightbox_load_balancer.by_name", "listener.#", "1"),
+					resource.TestCheckResourceAttr(
+						"data.brightbox_load_balancer.by_name", "healthcheck.0.port", "8080"),
+					resource.TestCheckResourceAttrPair(
+						"data.brightbox_load_balancer.by_id", "name",
+						"brightbox_load_balancer.foobar", "name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckBrightboxDataLoadBalancerConfig_basic(rInt int) string {
+	return fmt.Sprintf(`
+resource "brightbox_load_balancer" "foobar" {
+	name = "foo-%d"
+	listener {
+		protocol = "http"
+		in = 80
+		out = 8080
+	}
+	healthcheck {
+		type = "http"
+		port = 8080
+	}
+}
+
+data "brightbox_load_balancer" "by_name" {
+	name = "^${brightbox_load_balancer.foobar.name}$"
+}
+
+data "brightbox_load_balancer" "by_id" {
+	id = brightbox_load_balancer.foobar.id
+}
+`, rInt)
+}
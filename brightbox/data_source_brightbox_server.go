@@ -0,0 +1,248 @@
+package brightbox
+
+import (
+	"regexp"
+
+	brightbox "github.com/brightbox/gobrightbox/v2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceBrightboxServer() *schema.Resource {
+	return &schema.Resource{
+		Description: "Brightbox Server",
+		ReadContext: datasourceBrightboxRead(
+			(*brightbox.Client).Servers,
+			"Server",
+			setServerAttributes,
+			findServerFunc,
+		),
+
+		Schema: map[string]*schema.Schema{
+
+			"cloud_ips": serverCloudIPsSchema(),
+
+			"data_volumes": {
+				Description: "List of volumes attached to the server",
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Set: schema.HashString,
+			},
+
+			"disk_encrypted": {
+				Description: "Is true if the server has been built with an encrypted disk",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+
+			"disk_size": {
+				Description: "Disk size in megabytes",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+
+			"fqdn": {
+				Description: "Fully qualified domain name",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+
+			"hostname": {
+				Description: "Short hostname",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+
+			"id": {
+				Description:  "The ID of the server to find",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringMatch(serverRegexp, "must be a valid server ID"),
+			},
+
+			"image": {
+				Description: "Image used to create the server",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+
+			"interface": {
+				Description: "Network Interface connected to this server",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+
+			"interfaces": serverInterfacesSchema(),
+
+			"ipv4_address": {
+				Description: "Public IPv4 address of the interface",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+
+			"ipv4_address_private": {
+				Description: "Private IPv4 address of the interface",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+
+			"ipv6_address": {
+				Description: "Public IPv6 address of the interface",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+
+			"ipv6_hostname": {
+				Description: "Public IPv6 FQDN",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+
+			"locked": {
+				Description: "Is true if resource has been set as locked and cannot be deleted",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+
+			"name": {
+				Description:  "A regex to match against the server name",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+
+			"public_hostname": {
+				Description: "Public IPv4 FQDN",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+
+			"server_group": {
+				Description:  "Only match servers in this server group",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringMatch(serverGroupRegexp, "must be a valid server group ID"),
+			},
+
+			"server_groups": {
+				Description: "List of server groups the server is in",
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"snapshots_retention": {
+				Description: "Keep this number of scheduled snapshots. Keep all if unset",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+
+			"snapshots_schedule": {
+				Description: "Crontab pattern for scheduled snapshots",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+
+			"snapshots_schedule_next_at": {
+				Description: "time in UTC when next approximate scheduled snapshot will be run",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+
+			"status": {
+				Description: "Current state of server",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+
+			"type": {
+				Description: "Server type of the server",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+
+			"user_data": {
+				Description: "Hash of the data made available to Cloud Init",
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+			},
+
+			"username": {
+				Description: "Username to use when logging into a server",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+
+			"volume": {
+				Description: "Volume used to boot the server",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+
+			"zone": {
+				Description:  "Zone where server is located",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringMatch(zoneRegexp, "must be a valid zone ID or handle"),
+			},
+		},
+	}
+}
+
+func findServerFunc(
+	d *schema.ResourceData,
+) (func(brightbox.Server) bool, diag.Diagnostics) {
+	return serverMatcher(
+		d.Get("id").(string),
+		d.Get("name").(string),
+		d.Get("server_group").(string),
+		d.Get("zone").(string),
+	)
+}
+
+// serverMatcher selects the available servers matching all the given
+// criteria. Empty criteria match anything.
+func serverMatcher(
+	id string,
+	name string,
+	group string,
+	zone string,
+) (func(brightbox.Server) bool, diag.Diagnostics) {
+	var nameRe *regexp.Regexp
+	var err error
+	var diags diag.Diagnostics
+	if name != "" {
+		if nameRe, err = regexp.Compile(name); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+	}
+
+	return func(object brightbox.Server) bool {
+		if serverUnavailable(&object) {
+			return false
+		}
+		if id != "" && object.ID != id {
+			return false
+		}
+		if nameRe != nil && !nameRe.MatchString(object.Name) {
+			return false
+		}
+		if zone != "" && (object.Zone == nil || (object.Zone.ID != zone && object.Zone.Handle != zone)) {
+			return false
+		}
+		if group != "" && len(filter(object.ServerGroups, func(v brightbox.ServerGroup) bool { return v.ID == group })) == 0 {
+			return false
+		}
+		return true
+	}, diags
+}
@@ -0,0 +1,161 @@
+package brightbox
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	brightbox "github.com/brightbox/gobrightbox/v2"
+	"github.com/brightbox/gobrightbox/v2/enums/serverstatus"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccBrightboxDataServer_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders(),
+		CheckDestroy:      testAccCheckBrightboxServerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckBrightboxDataServerConfig_basic(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBrightboxDataSourceID("Server", "data.brightbox_server.by_name"),
+					resource.TestCheckResourceAttrPair(
+						"data.brightbox_server.by_name", "id",
+						"brightbox_server.foobar", "id"),
+					resource.TestCheckResourceAttrPair(
+						"data.brightbox_server.by_name", "fqdn",
+						"brightbox_server.foobar", "fqdn"),
+					resource.TestCheckResourceAttrPair(
+						"data.brightbox_server.by_name", "ipv6_address",
+						"brightbox_server.foobar", "ipv6_address"),
+					resource.TestCheckResourceAttrPair(
+						"data.brightbox_server.by_id", "name",
+						"brightbox_server.foobar", "name"),
+					resource.TestCheckResourceAttr(
+						"data.brightbox_servers.foobar", "ids.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.brightbox_servers.foobar", "servers.0.id",
+						"brightbox_server.foobar", "id"),
+					resource.TestCheckResourceAttrPair(
+						"data.brightbox_servers.foobar", "servers.0.ipv4_address_private",
+						"brightbox_server.foobar", "ipv4_address_private"),
+					resource.TestCheckResourceAttrPair(
+						"data.brightbox_servers.foobar", "servers.0.server_groups.0",
+						"data.brightbox_server_group.default", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckBrightboxDataServerConfig_basic(rInt int) string {
+	return fmt.Sprintf(`
+resource "brightbox_server" "foobar" {
+	image = data.brightbox_image.foobar.id
+	name = "foo-%d"
+	type = "1gb.ssd"
+	server_groups = [data.brightbox_server_group.default.id]
+}
+
+data "brightbox_server" "by_name" {
+	name = "^${brightbox_server.foobar.name}$"
+	server_group = data.brightbox_server_group.default.id
+}
+
+data "brightbox_server" "by_id" {
+	id = brightbox_server.foobar.id
+}
+
+data "brightbox_servers" "foobar" {
+	name = "^${brightbox_server.foobar.name}$"
+	zone = brightbox_server.foobar.zone
+}
+
+%s%s`, rInt, TestAccBrightboxImageDataSourceConfig_blank_disk,
+		TestAccBrightboxDataServerGroupConfig_default)
+}
+
+func TestServerMatcher(t *testing.T) {
+	servers := []brightbox.Server{
+		{
+			ID:           "srv-aaaaa",
+			Name:         "web-1",
+			Status:       serverstatus.Active,
+			Zone:         &brightbox.Zone{ID: "zon-aaaaa", Handle: "gb1-a"},
+			ServerGroups: []brightbox.ServerGroup{{ID: "grp-aaaaa"}},
+		},
+		{
+			ID:           "srv-bbbbb",
+			Name:         "web-2",
+			Status:       serverstatus.Inactive,
+			Zone:         &brightbox.Zone{ID: "zon-bbbbb", Handle: "gb1-b"},
+			ServerGroups: []brightbox.ServerGroup{{ID: "grp-aaaaa"}, {ID: "grp-bbbbb"}},
+		},
+		{
+			ID:     "srv-ccccc",
+			Name:   "web-3",
+			Status: serverstatus.Deleted,
+			Zone:   &brightbox.Zone{ID: "zon-aaaaa", Handle: "gb1-a"},
+		},
+	}
+	testCases := []struct {
+		id, name, group, zone string
+		expected              []string
+	}{
+		{"", "", "", "", []string{"srv-aaaaa", "srv-bbbbb"}},
+		{"srv-bbbbb", "", "", "", []string{"srv-bbbbb"}},
+		{"srv-ccccc", "", "", "", nil},
+		{"", "^web-1$", "", "", []string{"srv-aaaaa"}},
+		{"", "", "grp-bbbbb", "", []string{"srv-bbbbb"}},
+		{"", "", "grp-aaaaa", "gb1-a", []string{"srv-aaaaa"}},
+		{"", "", "", "zon-bbbbb", []string{"srv-bbbbb"}},
+		{"", "web", "grp-ccccc", "", nil},
+	}
+	for _, tcase := range testCases {
+		matcher, diags := serverMatcher(tcase.id, tcase.name, tcase.group, tcase.zone)
+		if diags.HasError() {
+			t.Fatalf("unexpected error: %v", diags)
+		}
+		result := idList(filter(servers, matcher), func(v brightbox.Server) string { return v.ID })
+		if fmt.Sprint(result) != fmt.Sprint(tcase.expected) {
+			t.Errorf("%+v: expected %v, got %v", tcase, tcase.expected, result)
+		}
+	}
+}
+
+func TestServerMatcherInvalidRegexp(t *testing.T) {
+	if _, diags := serverMatcher("", "web-[", "", ""); !diags.HasError() {
+		t.Error("expected an error for an invalid name regex")
+	}
+}
+
+func TestServerDataSourceUserData(t *testing.T) {
+	userData := "#!/bin/sh\necho hello\n"
+	client := newFakeAPIClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[{"id":"srv-aaaaa","name":"web","status":"active","hostname":"srv-aaaaa",
+			"user_data":"` + base64Encode(userData) + `",
+			"image":{"id":"img-aaaaa","username":"ubuntu"},
+			"server_type":{"id":"typ-aaaaa","handle":"1gb.ssd","disk_size":30720},
+			"zone":{"id":"zon-aaaaa","handle":"gb1-a"}}]`))
+	})
+	server := dataSourceBrightboxServer()
+	d := server.TestResourceData()
+	if err := d.Set("name", "^web$"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	diags := server.ReadContext(context.Background(), d, &CompositeClient{APIClient: client})
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if d.Id() != "srv-aaaaa" {
+		t.Errorf("expected srv-aaaaa, got %q", d.Id())
+	}
+	if d.Get("user_data") != userDataHashSum(userData) {
+		t.Errorf("expected the user data hash, got %q", d.Get("user_data"))
+	}
+}
@@ -4,18 +4,25 @@ import (
 	"regexp"
 
 	brightbox "github.com/brightbox/gobrightbox/v2"
+	"github.com/brightbox/gobrightbox/v2/enums/storagetype"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+const selectSmallest = "smallest"
+
+var validSelectModes = []string{selectSmallest}
+
 func dataSourceBrightboxServerType() *schema.Resource {
 	return &schema.Resource{
 		Description: "Brightbox Cloud SQL server type",
-		ReadContext: datasourceBrightboxRead(
+		ReadContext: datasourceBrightboxSmallestRead(
 			(*brightbox.Client).ServerTypes,
 			"Server Type",
 			dataSourceBrightboxServerTypesAttributes,
 			findServerTypeFunc,
+			serverTypeLess,
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -39,6 +46,27 @@ func dataSourceBrightboxServerType() *schema.Resource {
 				Computed:    true,
 			},
 
+			"min_cores": {
+				Description:  "Only match server types with at least this many CPU cores",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+
+			"min_disk_size": {
+				Description:  "Only match server types with at least this disk size in megabytes",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+
+			"min_ram": {
+				Description:  "Only match server types with at least this RAM size in megabytes",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+
 			"name": {
 				Description: "Name of this server type",
 				Type:        schema.TypeString,
@@ -46,6 +74,16 @@ func dataSourceBrightboxServerType() *schema.Resource {
 				Computed:    true,
 			},
 
+			"select": {
+				Description: "How to choose between several matching types: `smallest` picks the one with the least RAM, then cores, then disk",
+				Type:        schema.TypeString,
+				Optional:    true,
+				ValidateFunc: validation.StringInSlice(
+					validSelectModes,
+					false,
+				),
+			},
+
 			"ram": {
 				Description: "RAM size in megabytes",
 				Type:        schema.TypeInt,
@@ -61,7 +99,12 @@ func dataSourceBrightboxServerType() *schema.Resource {
 			"storage_type": {
 				Description: "If the server type uses local or network storage",
 				Type:        schema.TypeString,
+				Optional:    true,
 				Computed:    true,
+				ValidateFunc: validation.StringInSlice(
+					storagetype.ValidStrings,
+					false,
+				),
 			},
 		},
 	}
@@ -123,6 +166,10 @@ func findServerTypeFunc(
 			diags = append(diags, diag.FromErr(err)...)
 		}
 	}
+	storageType, storageTypeok := d.GetOk("storage_type")
+	minCores := uint(d.Get("min_cores").(int))
+	minRAM := uint(d.Get("min_ram").(int))
+	minDiskSize := uint(d.Get("min_disk_size").(int))
 
 	return func(object brightbox.ServerType) bool {
 		if nameRe != nil && !nameRe.MatchString(object.Name) {
@@ -131,6 +178,24 @@ func findServerTypeFunc(
 		if descRe != nil && !descRe.MatchString(object.Handle) {
 			return false
 		}
+		if storageTypeok && storageType.(string) != object.StorageType.String() {
+			return false
+		}
+		if object.Cores < minCores || object.RAM < minRAM || object.DiskSize < minDiskSize {
+			return false
+		}
 		return true
 	}, diags
 }
+
+// serverTypeLess orders server types by RAM, then cores, then disk size,
+// which follows their price
+func serverTypeLess(a, b brightbox.ServerType) bool {
+	if a.RAM != b.RAM {
+		return a.RAM < b.RAM
+	}
+	if a.Cores != b.Cores {
+		return a.Cores < b.Cores
+	}
+	return a.DiskSize < b.DiskSize
+}
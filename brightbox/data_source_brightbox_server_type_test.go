@@ -3,7 +3,10 @@ package brightbox
 import (
 	"testing"
 
+	brightbox "github.com/brightbox/gobrightbox/v2"
+	"github.com/brightbox/gobrightbox/v2/enums/storagetype"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func TestAccBrightboxServerType_basic(t *testing.T) {
@@ -29,8 +32,90 @@ func TestAccBrightboxServerType_basic(t *testing.T) {
 	})
 }
 
+func TestAccBrightboxServerType_smallest(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config: TestAccBrightboxServerTypeConfig_smallest,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBrightboxDataSourceID("Server Type", "data.brightbox_server_type.foobar"),
+					resource.TestCheckResourceAttr(
+						"data.brightbox_server_type.foobar", "handle", "4gb.nbs"),
+					resource.TestCheckResourceAttr(
+						"data.brightbox_server_type.foobar", "ram", "4096"),
+					resource.TestCheckResourceAttr(
+						"data.brightbox_server_type.foobar", "storage_type", "network"),
+				),
+			},
+		},
+	})
+}
+
+const TestAccBrightboxServerTypeConfig_smallest = `
+data "brightbox_server_type" "foobar" {
+	min_ram = 4096
+	storage_type = "network"
+	select = "smallest"
+}
+`
+
 const TestAccBrightboxServerTypeConfig_basic = `
 data "brightbox_server_type" "foobar" {
 	handle = "^4gb.nbs$"
 }
 `
+
+var serverTypeFixtures = []brightbox.ServerType{
+	{ID: "typ-8gbnb", Handle: "8gb.nbs", RAM: 8192, Cores: 4, StorageType: storagetype.Network},
+	{ID: "typ-4gbhc", Handle: "4gb.hc.nbs", RAM: 4096, Cores: 4, StorageType: storagetype.Network},
+	{ID: "typ-4gbss", Handle: "4gb.ssd", RAM: 4096, Cores: 2, DiskSize: 81920, StorageType: storagetype.Local},
+	{ID: "typ-4gbnb", Handle: "4gb.nbs", RAM: 4096, Cores: 2, StorageType: storagetype.Network},
+	{ID: "typ-2gbss", Handle: "2gb.ssd", RAM: 2048, Cores: 1, DiskSize: 40960, StorageType: storagetype.Local},
+	{ID: "typ-1gbss", Handle: "1gb.ssd", RAM: 1024, Cores: 1, DiskSize: 20480, StorageType: storagetype.Local},
+}
+
+func TestSmallestServerType(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		config   map[string]interface{}
+		expected string
+	}{
+		{"no minimums", map[string]interface{}{}, "typ-1gbss"},
+		{"minimum ram", map[string]interface{}{"min_ram": 4096}, "typ-4gbnb"},
+		{"minimum cores", map[string]interface{}{"min_cores": 3}, "typ-4gbhc"},
+		{"minimum disk", map[string]interface{}{"min_ram": 4096, "min_disk_size": 1}, "typ-4gbss"},
+		{"network storage", map[string]interface{}{"storage_type": "network"}, "typ-4gbnb"},
+		{"local storage", map[string]interface{}{"storage_type": "local", "min_ram": 1536}, "typ-2gbss"},
+		{"handle", map[string]interface{}{"handle": "^8gb", "min_cores": 2}, "typ-8gbnb"},
+	}
+	for _, tcase := range testCases {
+		t.Run(tcase.desc, func(t *testing.T) {
+			tcase.config["select"] = selectSmallest
+			d := schema.TestResourceDataRaw(t, dataSourceBrightboxServerType().Schema, tcase.config)
+			matcher, diags := findServerTypeFunc(d)
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+			results := filter(serverTypeFixtures, matcher)
+			if len(results) == 0 {
+				t.Fatalf("expected %s, got no matches", tcase.expected)
+			}
+			if result := smallest(results, serverTypeLess); result.ID != tcase.expected {
+				t.Errorf("expected %s, got %s", tcase.expected, result.ID)
+			}
+		})
+	}
+}
+
+func TestSmallestServerTypeNoMatch(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, dataSourceBrightboxServerType().Schema, map[string]interface{}{
+		"min_ram":      16384,
+		"storage_type": "local",
+	})
+	matcher, _ := findServerTypeFunc(d)
+	if results := filter(serverTypeFixtures, matcher); len(results) != 0 {
+		t.Errorf("expected no matches, got %v", results)
+	}
+}
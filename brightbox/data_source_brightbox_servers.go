@@ -0,0 +1,223 @@
+package brightbox
+
+import (
+	brightbox "github.com/brightbox/gobrightbox/v2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceBrightboxServers() *schema.Resource {
+	return &schema.Resource{
+		Description: "Brightbox Servers",
+		ReadContext: datasourceBrightboxListRead(
+			(*brightbox.Client).Servers,
+			"Server",
+			setServersAttributes,
+			findServersFunc,
+		),
+
+		Schema: map[string]*schema.Schema{
+
+			"ids": {
+				Description: "IDs of the matching servers",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"name": {
+				Description:  "A regex to match against the server names",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+
+			"server_group": {
+				Description:  "Only match servers in this server group",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringMatch(serverGroupRegexp, "must be a valid server group ID"),
+			},
+
+			"servers": {
+				Description: "The matching servers",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cloud_ips": serverCloudIPsSchema(),
+						"fqdn": {
+							Description: "Fully qualified domain name",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"hostname": {
+							Description: "Short hostname",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"id": {
+							Description: "The ID of the server",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"image": {
+							Description: "Image used to create the server",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"interface": {
+							Description: "Network Interface connected to this server",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"interfaces": serverInterfacesSchema(),
+						"ipv4_address": {
+							Description: "Public IPv4 address of the interface",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"ipv4_address_private": {
+							Description: "Private IPv4 address of the interface",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"ipv6_address": {
+							Description: "Public IPv6 address of the interface",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"ipv6_hostname": {
+							Description: "Public IPv6 FQDN",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"locked": {
+							Description: "Is true if resource has been set as locked and cannot be deleted",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+						"name": {
+							Description: "Editable user label",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"public_hostname": {
+							Description: "Public IPv4 FQDN",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"server_groups": {
+							Description: "List of server groups the server is in",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"status": {
+							Description: "Current state of server",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"type": {
+							Description: "Server type of the server",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"zone": {
+							Description: "Zone where server is located",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+
+			"zone": {
+				Description:  "Only match servers in this zone",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringMatch(zoneRegexp, "must be a valid zone ID or handle"),
+			},
+		},
+	}
+}
+
+func findServersFunc(
+	d *schema.ResourceData,
+) (func(brightbox.Server) bool, diag.Diagnostics) {
+	return serverMatcher(
+		"",
+		d.Get("name").(string),
+		d.Get("server_group").(string),
+		d.Get("zone").(string),
+	)
+}
+
+func setServersAttributes(
+	d *schema.ResourceData,
+	servers []brightbox.Server,
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var err error
+
+	ids := idList(servers, func(v brightbox.Server) string { return v.ID })
+	d.SetId(listID(ids))
+	err = d.Set("ids", ids)
+	if err != nil {
+		diags = append(diags, diag.Errorf("unexpected: %s", err)...)
+	}
+	flattened := make([]interface{}, 0, len(servers))
+	for i := range servers {
+		flattened = append(flattened, flattenServer(&servers[i]))
+	}
+	err = d.Set("servers", flattened)
+	if err != nil {
+		diags = append(diags, diag.Errorf("unexpected: %s", err)...)
+	}
+	return diags
+}
+
+// flattenServer maps a server onto the attribute names used by the
+// server resource
+func flattenServer(server *brightbox.Server) map[string]interface{} {
+	result := map[string]interface{}{
+		"id":       server.ID,
+		"name":     server.Name,
+		"status":   server.Status.String(),
+		"locked":   server.Locked,
+		"hostname": server.Hostname,
+		"fqdn":     server.Fqdn,
+		"server_groups": idList(
+			server.ServerGroups,
+			func(v brightbox.ServerGroup) string { return v.ID },
+		),
+	}
+	if server.Image != nil {
+		result["image"] = server.Image.ID
+	}
+	if server.Zone != nil {
+		result["zone"] = server.Zone.Handle
+	}
+	if server.ServerType != nil {
+		result["type"] = server.ServerType.ID
+	}
+	if len(server.Interfaces) > 0 {
+		serverInterface := server.Interfaces[0]
+		result["interface"] = serverInterface.ID
+		result["ipv4_address_private"] = serverInterface.IPv4Address
+		result["ipv6_address"] = serverInterface.IPv6Address
+		result["ipv6_hostname"] = "ipv6." + server.Fqdn
+	}
+	if len(server.CloudIPs) > 0 {
+		result["ipv4_address"] = server.CloudIPs[0].PublicIP
+		result["public_hostname"] = server.CloudIPs[0].Fqdn
+	}
+	result["interfaces"] = flattenServerInterfaces(server)
+	result["cloud_ips"] = flattenServerCloudIPs(server)
+	return result
+}
@@ -0,0 +1,145 @@
+package brightbox
+
+import (
+	"regexp"
+
+	brightbox "github.com/brightbox/gobrightbox/v2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceBrightboxVolume() *schema.Resource {
+	return &schema.Resource{
+		Description: "Brightbox Volume",
+		ReadContext: datasourceBrightboxRead(
+			(*brightbox.Client).Volumes,
+			"Volume",
+			setVolumeAttributes,
+			findVolumeFunc,
+		),
+
+		Schema: map[string]*schema.Schema{
+			"description": {
+				Description: "Verbose Description of this volume",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+
+			"encrypted": {
+				Description: "Is true if the volume is encrypted",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+
+			"filesystem_label": {
+				Description: "Label given to the filesystem on the volume",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+
+			"filesystem_type": {
+				Description: "Format of the filesystem on the volume",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+
+			"id": {
+				Description:  "The ID of the volume to find",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringMatch(volumeRegexp, "must be a valid volume ID"),
+			},
+
+			"image": {
+				Description: "Image used to create the volume",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+
+			"locked": {
+				Description: "Is true if the volume is set as locked and cannot be deleted",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+
+			"name": {
+				Description:  "A regex to match against the volume name",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+
+			"serial": {
+				Description: "Volume Serial Number",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+
+			"server": {
+				Description: "ID of the server this volume is mapped to",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+
+			"size": {
+				Description: "Disk size in megabytes",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+
+			"source": {
+				Description: "ID of the source volume for this image",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+
+			"source_type": {
+				Description: "Source type for this image (image, volume or raw)",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+
+			"status": {
+				Description: "Current state of volume",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+
+			"storage_type": {
+				Description: "Storage type for this volume (local or network)",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func findVolumeFunc(
+	d *schema.ResourceData,
+) (func(brightbox.Volume) bool, diag.Diagnostics) {
+	var nameRe *regexp.Regexp
+	var err error
+	var diags diag.Diagnostics
+	id := d.Get("id").(string)
+	if temp, ok := d.GetOk("name"); ok {
+		if nameRe, err = regexp.Compile(temp.(string)); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+	}
+
+	return func(object brightbox.Volume) bool {
+		if volumeUnavailable(&object) {
+			return false
+		}
+		if id != "" && object.ID != id {
+			return false
+		}
+		if nameRe != nil && !nameRe.MatchString(object.Name) {
+			return false
+		}
+		return true
+	}, diags
+}
@@ -0,0 +1,50 @@
+package brightbox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccBrightboxDataVolume_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders(),
+		CheckDestroy:      testAccCheckBrightboxVolumeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckBrightboxDataVolumeConfig_basic(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBrightboxDataSourceID("Volume", "data.brightbox_volume.by_name"),
+					resource.TestCheckResourceAttrPair(
+						"data.brightbox_volume.by_name", "id",
+						"brightbox_volume.foobar", "id"),
+					resource.TestCheckResourceAttr(
+						"data.brightbox_volume.by_name", "size", "20480"),
+					resource.TestCheckResourceAttr(
+						"data.brightbox_volume.by_name", "description", "Hello"),
+					resource.TestCheckResourceAttrPair(
+						"data.brightbox_volume.by_id", "name",
+						"brightbox_volume.foobar", "name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckBrightboxDataVolumeConfig_basic(rInt int) string {
+	return fmt.Sprintf(`
+%s
+
+data "brightbox_volume" "by_name" {
+	name = "^${brightbox_volume.foobar.name}$"
+}
+
+data "brightbox_volume" "by_id" {
+	id = brightbox_volume.foobar.id
+}
+`, testAccCheckBrightboxVolumeConfig_rawDefault(rInt))
+}
@@ -0,0 +1,181 @@
+package brightbox
+
+import (
+	"regexp"
+
+	brightbox "github.com/brightbox/gobrightbox/v2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceBrightboxZone() *schema.Resource {
+	return &schema.Resource{
+		Description: "Brightbox Zone",
+		ReadContext: datasourceBrightboxRead(
+			(*brightbox.Client).Zones,
+			"Zone",
+			setZoneAttributes,
+			findZoneFunc,
+		),
+
+		Schema: map[string]*schema.Schema{
+
+			"handle": {
+				Description:  "A regex to match against the zone handle",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+
+			"id": {
+				Description:  "The ID of the zone to find",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringMatch(zoneIDRegexp, "must be a valid zone ID"),
+			},
+		},
+	}
+}
+
+func dataSourceBrightboxZones() *schema.Resource {
+	return &schema.Resource{
+		Description: "Brightbox Zones",
+		ReadContext: datasourceBrightboxListRead(
+			(*brightbox.Client).Zones,
+			"Zone",
+			setZonesAttributes,
+			findZonesFunc,
+		),
+
+		Schema: map[string]*schema.Schema{
+
+			"handle": {
+				Description:  "A regex to match against the zone handles",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+
+			"handles": {
+				Description: "Handles of the matching zones",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"ids": {
+				Description: "IDs of the matching zones",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"zones": {
+				Description: "The matching zones",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"handle": {
+							Description: "The handle of the zone",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"id": {
+							Description: "The ID of the zone",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func setZoneAttributes(
+	d *schema.ResourceData,
+	zone *brightbox.Zone,
+) diag.Diagnostics {
+	d.SetId(zone.ID)
+	if err := d.Set("handle", zone.Handle); err != nil {
+		return diag.Errorf("unexpected: %s", err)
+	}
+	return nil
+}
+
+func setZonesAttributes(
+	d *schema.ResourceData,
+	zones []brightbox.Zone,
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var err error
+
+	ids := idList(zones, func(v brightbox.Zone) string { return v.ID })
+	d.SetId(listID(ids))
+	err = d.Set("ids", ids)
+	if err != nil {
+		diags = append(diags, diag.Errorf("unexpected: %s", err)...)
+	}
+	err = d.Set("handles", idList(zones, func(v brightbox.Zone) string { return v.Handle }))
+	if err != nil {
+		diags = append(diags, diag.Errorf("unexpected: %s", err)...)
+	}
+	flattened := make([]interface{}, len(zones))
+	for i, zone := range zones {
+		flattened[i] = map[string]interface{}{
+			"id":     zone.ID,
+			"handle": zone.Handle,
+		}
+	}
+	err = d.Set("zones", flattened)
+	if err != nil {
+		diags = append(diags, diag.Errorf("unexpected: %s", err)...)
+	}
+	return diags
+}
+
+func findZoneFunc(
+	d *schema.ResourceData,
+) (func(brightbox.Zone) bool, diag.Diagnostics) {
+	return zoneMatcher(d.Get("id").(string), d.Get("handle").(string))
+}
+
+func findZonesFunc(
+	d *schema.ResourceData,
+) (func(brightbox.Zone) bool, diag.Diagnostics) {
+	return zoneMatcher("", d.Get("handle").(string))
+}
+
+// zoneMatcher selects the zones matching all the given criteria. Empty
+// criteria match anything.
+func zoneMatcher(
+	id string,
+	handle string,
+) (func(brightbox.Zone) bool, diag.Diagnostics) {
+	var handleRe *regexp.Regexp
+	var err error
+	var diags diag.Diagnostics
+	if handle != "" {
+		if handleRe, err = regexp.Compile(handle); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+	}
+
+	return func(object brightbox.Zone) bool {
+		if id != "" && object.ID != id {
+			return false
+		}
+		if handleRe != nil && !handleRe.MatchString(object.Handle) {
+			return false
+		}
+		return true
+	}, diags
+}
@@ -0,0 +1,69 @@
+package brightbox
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccBrightboxDataZone_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config: TestAccBrightboxDataZoneConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBrightboxDataSourceID("Zone", "data.brightbox_zone.a"),
+					resource.TestMatchResourceAttr(
+						"data.brightbox_zone.a", "id", zoneIDRegexp),
+					resource.TestCheckResourceAttr(
+						"data.brightbox_zone.a", "handle", "gb1-a"),
+					testAccCheckBrightboxDataSourceID("Zones", "data.brightbox_zones.all"),
+					resource.TestCheckResourceAttr(
+						"data.brightbox_zones.all", "ids.#", "2"),
+					resource.TestCheckTypeSetElemAttr(
+						"data.brightbox_zones.all", "handles.*", "gb1-b"),
+					resource.TestCheckTypeSetElemAttrPair(
+						"data.brightbox_zones.all", "ids.*",
+						"data.brightbox_zone.a", "id"),
+				),
+			},
+		},
+	})
+}
+
+const TestAccBrightboxDataZoneConfig_basic = `
+data "brightbox_zone" "a" {
+	handle = "^gb1-a$"
+}
+
+data "brightbox_zones" "all" {
+	handle = "^gb1-"
+}
+`
+
+func TestZoneRegexp(t *testing.T) {
+	testCases := []struct {
+		zone  string
+		valid bool
+	}{
+		{"gb1-a", true},
+		{"gb1s-b", true},
+		{"gb2-c", true},
+		{"zon-12345", true},
+		{"gb1", false},
+		{"GB1-A", false},
+		{"gb1-", false},
+		{"gb1-ab", false},
+		{"srv-12345", false},
+		{"my-zone-name", false},
+		{"zon-1234", false},
+		{"", false},
+	}
+	for _, tcase := range testCases {
+		if result := zoneRegexp.MatchString(tcase.zone); result != tcase.valid {
+			t.Errorf("%q: expected %v, got %v", tcase.zone, tcase.valid, result)
+		}
+	}
+}
@@ -1,12 +1,16 @@
 package brightbox
 
 import (
+	"context"
 	"errors"
-	"log"
+	"fmt"
+	"regexp"
 	"strings"
 
 	brightbox "github.com/brightbox/gobrightbox/v2"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"golang.org/x/oauth2"
 )
 
@@ -19,15 +23,12 @@ func brightboxFromErrSlice(err error) diag.Diagnostics {
 func brightboxFromErr(err error) diag.Diagnostic {
 	var brightboxError *brightbox.APIError
 	if errors.As(err, &brightboxError) {
-		log.Printf("[DEBUG] Returning API Diagnostic")
-		return apiErrorDiagnostic(brightboxError)
+		return apiErrorDiagnostic(brightboxError, apiErrorRequestID(err))
 	}
 	var oauthError *oauth2.RetrieveError
 	if errors.As(err, &oauthError) {
-		log.Printf("[DEBUG] Returning Oauth2 Diagnostic")
 		return oauth2ErrorDiagnostic(oauthError)
 	}
-	log.Printf("[DEBUG] Returning Normal Error with type %T", err)
 	return diag.Diagnostic{
 		Severity: diag.Error,
 		Summary:  err.Error(),
@@ -50,10 +51,160 @@ func oauth2ErrorDiagnostic(err *oauth2.RetrieveError) diag.Diagnostic {
 	return result
 }
 
-func apiErrorDiagnostic(err *brightbox.APIError) diag.Diagnostic {
-	return diag.Diagnostic{
+func apiErrorDiagnostic(err *brightbox.APIError, requestID string) diag.Diagnostic {
+	summary := err.ErrorName
+	if summary == "" {
+		summary = err.Error()
+	}
+	var detail []string
+	if len(err.Errors) > 0 {
+		detail = append(detail, strings.Join(err.Errors, ", "))
+	}
+	if err.Status != "" {
+		detail = append(detail, "HTTP status: "+err.Status)
+	}
+	if requestID != "" {
+		detail = append(detail, "Request ID: "+requestID)
+	}
+	if hint := apiErrorHint(err); hint != "" {
+		detail = append(detail, hint)
+	}
+	result := diag.Diagnostic{
 		Severity: diag.Error,
-		Summary:  err.ErrorName,
-		Detail:   strings.Join(err.Errors, ", "),
+		Summary:  summary,
+		Detail:   strings.Join(detail, "\n"),
+	}
+	if field := apiErrorField(err.Errors); field != "" {
+		result.AttributePath = cty.GetAttrPath(field)
+	}
+	return result
+}
+
+var (
+	// "zone: is invalid"
+	prefixedFieldRegexp = regexp.MustCompile(`^([a-z][a-z0-9_]*): `)
+	// "Missing parameter: server_type", "Unknown parameter zone"
+	parameterFieldRegexp = regexp.MustCompile(`(?i)\bparameters?:? '?([a-z][a-z0-9_]*)'?`)
+	// "Server type is invalid", "Name can't be blank"
+	humanFieldRegexp = regexp.MustCompile(`^([A-Z][a-z0-9]*(?: [a-z0-9]+)*?) (?:is|are|has|have|can't|cannot|must|was|were|does|doesn't|should|not)\b`)
+)
+
+// apiErrorField extracts the API field named by the first of the
+// error messages that names one, in snake case
+func apiErrorField(messages []string) string {
+	for _, message := range messages {
+		for _, expression := range []*regexp.Regexp{prefixedFieldRegexp, parameterFieldRegexp, humanFieldRegexp} {
+			if match := expression.FindStringSubmatch(message); match != nil {
+				return strings.ReplaceAll(strings.ToLower(match[1]), " ", "_")
+			}
+		}
+	}
+	return ""
+}
+
+var (
+	lockedHintRegexp  = regexp.MustCompile(`\blocked\b`)
+	quotaHintRegexp   = regexp.MustCompile(`\bquota\b|\b(?:account|resource) limits?\b|\blimit (?:reached|exceeded)\b`)
+	zoneHintRegexp    = regexp.MustCompile(`\bzone\b`)
+	invalidHintRegexp = regexp.MustCompile(`\binvalid\b`)
+)
+
+// apiErrorHint suggests a remedy for the common API errors, matching
+// whole words of the error name and messages
+func apiErrorHint(err *brightbox.APIError) string {
+	text := strings.ToLower(strings.ReplaceAll(err.ErrorName, "_", " ") + " " + strings.Join(err.Errors, " "))
+	switch {
+	case lockedHintRegexp.MatchString(text):
+		return "The object is locked against changes. Set `locked = false` on the resource, or unlock it in the Brightbox Manager, and try again."
+	case quotaHintRegexp.MatchString(text):
+		return "The account has reached one of its limits. Remove objects that are no longer needed, or ask Brightbox Support to raise the limit."
+	case zoneHintRegexp.MatchString(text) && invalidHintRegexp.MatchString(text):
+		return "The zone is not recognised. Use a zone handle available in the region, such as `gb1-a` or `gb1-b`, or look them up with the `brightbox_zones` data source."
+	}
+	return ""
+}
+
+// apiFieldAttributes maps API field names to the schema attributes that
+// set them, where they differ, for each resource type
+var apiFieldAttributes = map[string]map[string]string{
+	"brightbox_server": {
+		"server_type": "type",
+		"volumes":     "volume",
+	},
+	"brightbox_database_server": {
+		"engine":  "database_engine",
+		"version": "database_version",
+	},
+	"brightbox_load_balancer": {
+		"listeners":   "listener",
+		"certificate": "certificate_pem",
+	},
+	"brightbox_cloudip": {
+		"port_translators": "port_translator",
+		"destination":      "target",
+	},
+}
+
+// addAttributePaths maps the attribute paths of API error diagnostics
+// returned by the operations of a resource or data source from API
+// field names onto its schema
+func addAttributePaths(resourceType string, resource *schema.Resource) {
+	resource.CreateContext = withAttributePaths(resourceType, resource, resource.CreateContext)
+	resource.ReadContext = withAttributePaths(resourceType, resource, resource.ReadContext)
+	resource.UpdateContext = withAttributePaths(resourceType, resource, resource.UpdateContext)
+	resource.DeleteContext = withAttributePaths(resourceType, resource, resource.DeleteContext)
+}
+
+func withAttributePaths[F ~func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics](
+	resourceType string,
+	resource *schema.Resource,
+	operation F,
+) F {
+	if operation == nil {
+		return nil
+	}
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		diags := operation(ctx, d, meta)
+		for i := range diags {
+			diags[i].AttributePath = schemaAttributePath(resourceType, resource, diags[i].AttributePath)
+		}
+		return diags
+	}
+}
+
+// schemaAttributePath maps a single step path naming an API field onto
+// the schema of the resource, dropping it if there is no match
+func schemaAttributePath(resourceType string, resource *schema.Resource, path cty.Path) cty.Path {
+	if len(path) != 1 {
+		return path
+	}
+	step, ok := path[0].(cty.GetAttrStep)
+	if !ok {
+		return path
+	}
+	name := step.Name
+	if attribute, ok := apiFieldAttributes[resourceType][name]; ok {
+		name = attribute
+	}
+	if _, ok := resource.Schema[name]; !ok {
+		return nil
+	}
+	return cty.GetAttrPath(name)
+}
+
+// diagnosticsError collapses the error diagnostics into a single error
+// for callers that cannot return diagnostics
+func diagnosticsError(diags diag.Diagnostics) error {
+	var errs []error
+	for _, v := range diags {
+		if v.Severity != diag.Error {
+			continue
+		}
+		if v.Detail == "" {
+			errs = append(errs, errors.New(v.Summary))
+		} else {
+			errs = append(errs, fmt.Errorf("%s: %s", v.Summary, v.Detail))
+		}
 	}
+	return errors.Join(errs...)
 }
@@ -0,0 +1,159 @@
+package brightbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	brightbox "github.com/brightbox/gobrightbox/v2"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAPIErrorField(t *testing.T) {
+	testCases := []struct {
+		messages []string
+		field    string
+	}{
+		{[]string{"Server type is invalid"}, "server_type"},
+		{[]string{"Name can't be blank"}, "name"},
+		{[]string{"zone: is not a valid zone"}, "zone"},
+		{[]string{"Missing parameter: image"}, "image"},
+		{[]string{"Unknown parameter 'snapshots_retention'"}, "snapshots_retention"},
+		{[]string{"Something went wrong", "Size must be larger"}, "size"},
+		{[]string{"Something went wrong"}, ""},
+		{nil, ""},
+	}
+	for _, tcase := range testCases {
+		if result := apiErrorField(tcase.messages); result != tcase.field {
+			t.Errorf("%q: expected field %q, got %q", tcase.messages, tcase.field, result)
+		}
+	}
+}
+
+func TestAPIErrorHint(t *testing.T) {
+	testCases := []struct {
+		err  *brightbox.APIError
+		hint string
+	}{
+		{&brightbox.APIError{ErrorName: "locked_resource"}, "locked"},
+		{&brightbox.APIError{ErrorName: "invalid_record", Errors: []string{"Account limit reached for servers"}}, "limits"},
+		{&brightbox.APIError{ErrorName: "quota_exceeded"}, "limits"},
+		{&brightbox.APIError{ErrorName: "invalid_record", Errors: []string{"Zone is invalid"}}, "gb1-a"},
+		{&brightbox.APIError{ErrorName: "invalid_record", Errors: []string{"Name can't be blank"}}, ""},
+		{&brightbox.APIError{ErrorName: "invalid_record", Errors: []string{"Server could not be unlocked"}}, ""},
+		{&brightbox.APIError{ErrorName: "invalid_record", Errors: []string{"Block device mapping is invalid"}}, ""},
+		{&brightbox.APIError{ErrorName: "invalid_record", Errors: []string{"Name exceeds the length limit"}}, ""},
+	}
+	for _, tcase := range testCases {
+		hint := apiErrorHint(tcase.err)
+		if tcase.hint == "" {
+			if hint != "" {
+				t.Errorf("%v: expected no hint, got %q", tcase.err, hint)
+			}
+		} else if !strings.Contains(hint, tcase.hint) {
+			t.Errorf("%v: expected hint mentioning %q, got %q", tcase.err, tcase.hint, hint)
+		}
+	}
+}
+
+func TestAPIErrorDiagnosticFromFakeAPI(t *testing.T) {
+	client := newFakeAPIClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set(requestIDHeader, "req-abc123")
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		w.Write([]byte(`{"error_name":"invalid_record","errors":["Zone is invalid"]}`))
+	})
+	zone := "gb1-z"
+	_, err := client.CreateServer(context.Background(), brightbox.ServerOptions{Zone: &zone})
+	var apiError *brightbox.APIError
+	if !errors.As(err, &apiError) {
+		t.Fatalf("expected an APIError, got %v", err)
+	}
+	result := brightboxFromErr(err)
+	if result.Summary != "invalid_record" {
+		t.Errorf("unexpected summary %q", result.Summary)
+	}
+	for _, expected := range []string{"Zone is invalid", "HTTP status: 422", "Request ID: req-abc123", "gb1-a"} {
+		if !strings.Contains(result.Detail, expected) {
+			t.Errorf("detail does not mention %q:\n%s", expected, result.Detail)
+		}
+	}
+	if !result.AttributePath.Equals(cty.GetAttrPath("zone")) {
+		t.Errorf("unexpected attribute path %#v", result.AttributePath)
+	}
+}
+
+func TestAPIErrorRequestIDSurvivesWrapping(t *testing.T) {
+	client := newFakeAPIClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(requestIDHeader, "req-abc123")
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+	_, err := client.Server(context.Background(), "srv-aaaaa")
+	err = fmt.Errorf("Error reading server: %w", err)
+	var apiError *brightbox.APIError
+	if !errors.As(err, &apiError) {
+		t.Fatalf("expected an APIError, got %v", err)
+	}
+	if apiError.StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("unexpected status %d", apiError.StatusCode)
+	}
+	if requestID := apiErrorRequestID(err); requestID != "req-abc123" {
+		t.Errorf("expected the request ID to survive wrapping, got %q", requestID)
+	}
+	if strings.Contains(err.Error(), "req-abc123") {
+		t.Errorf("expected the message to omit the request ID, got %q", err.Error())
+	}
+	result := brightboxFromErr(err)
+	if strings.Contains(result.Summary, "req-abc123") {
+		t.Errorf("expected the summary to omit the request ID, got %q", result.Summary)
+	}
+	if !strings.Contains(result.Detail, "Request ID: req-abc123") {
+		t.Errorf("detail does not mention the request ID:\n%s", result.Detail)
+	}
+}
+
+func TestRequestIDTransportLeavesTokenRequests(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(requestIDHeader, "req-abc123")
+		w.WriteHeader(http.StatusUnauthorized)
+		w.Write([]byte(`{"error":"invalid_client"}`))
+	}))
+	t.Cleanup(server.Close)
+	client := &http.Client{Transport: newRequestIDTransport(http.DefaultTransport)}
+	resp, err := client.Post(server.URL+"/token", "application/x-www-form-urlencoded", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusUnauthorized {
+		t.Errorf("unexpected status %d", resp.StatusCode)
+	}
+}
+
+func TestAttributePathsMappedToSchema(t *testing.T) {
+	resource := resourceBrightboxServer()
+	testCases := []struct {
+		in  cty.Path
+		out cty.Path
+	}{
+		{cty.GetAttrPath("server_type"), cty.GetAttrPath("type")},
+		{cty.GetAttrPath("zone"), cty.GetAttrPath("zone")},
+		{cty.GetAttrPath("no_such_field"), nil},
+		{nil, nil},
+	}
+	for _, tcase := range testCases {
+		operation := func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return diag.Diagnostics{{Severity: diag.Error, Summary: "failed", AttributePath: tcase.in}}
+		}
+		diags := withAttributePaths("brightbox_server", resource, operation)(context.Background(), resource.TestResourceData(), nil)
+		if !diags[0].AttributePath.Equals(tcase.out) {
+			t.Errorf("%#v: expected path %#v, got %#v", tcase.in, tcase.out, diags[0].AttributePath)
+		}
+	}
+}
@@ -0,0 +1,101 @@
+package brightbox
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ ephemeral.EphemeralResource              = (*apiClientSecretEphemeralResource)(nil)
+	_ ephemeral.EphemeralResourceWithConfigure = (*apiClientSecretEphemeralResource)(nil)
+)
+
+// apiClientSecretEphemeralResource resets the secret of an API client
+// and returns the new one without recording it in state
+type apiClientSecretEphemeralResource struct {
+	frameworkClient
+}
+
+type apiClientSecretModel struct {
+	Account   types.String `tfsdk:"account"`
+	APIClient types.String `tfsdk:"api_client"`
+	Secret    types.String `tfsdk:"secret"`
+}
+
+func newAPIClientSecretEphemeralResource() ephemeral.EphemeralResource {
+	return &apiClientSecretEphemeralResource{}
+}
+
+func (r *apiClientSecretEphemeralResource) Metadata(
+	_ context.Context,
+	req ephemeral.MetadataRequest,
+	resp *ephemeral.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_api_client_secret"
+}
+
+func (r *apiClientSecretEphemeralResource) Schema(
+	_ context.Context,
+	_ ephemeral.SchemaRequest,
+	resp *ephemeral.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "Resets the secret of a Brightbox API Client, without recording it in state",
+		Attributes: map[string]schema.Attribute{
+			"account": accountEphemeralAttribute(),
+			"api_client": schema.StringAttribute{
+				Description: "The ID of the API Client to reset",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(apiClientRegexp, "must be a valid API client ID"),
+				},
+			},
+			"secret": schema.StringAttribute{
+				Description: "The new secret of the API Client",
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+func (r *apiClientSecretEphemeralResource) Configure(
+	_ context.Context,
+	req ephemeral.ConfigureRequest,
+	resp *ephemeral.ConfigureResponse,
+) {
+	r.configure(req.ProviderData, &resp.Diagnostics)
+}
+
+func (r *apiClientSecretEphemeralResource) Open(
+	ctx context.Context,
+	req ephemeral.OpenRequest,
+	resp *ephemeral.OpenResponse,
+) {
+	var data apiClientSecretModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = contextWithFrameworkLogging(ctx, "brightbox_api_client_secret", data.APIClient.ValueString(), "secret")
+	client, diags := r.forAccount(ctx, data.Account)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Resetting API Client secret")
+	apiClient, err := client.APIClient.ResetAPIClientPassword(ctx, data.APIClient.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(frameworkDiagnostics(brightboxFromErrSlice(err))...)
+		return
+	}
+	data.Account = types.StringValue(client.Account)
+	data.Secret = types.StringValue(apiClient.Secret)
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
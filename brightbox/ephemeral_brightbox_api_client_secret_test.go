@@ -0,0 +1,34 @@
+package brightbox
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestAPIClientSecretEphemeralResource(t *testing.T) {
+	var resets int
+	client := newFakeAPIClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/1.0/api_clients/cli-abcde/reset_secret" {
+			http.NotFound(w, r)
+			return
+		}
+		resets++
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id":"cli-abcde","secret":"fresh-secret"}`))
+	})
+	var result apiClientSecretModel
+	openEphemeralResource(t, newAPIClientSecretEphemeralResource(), client, map[string]tftypes.Value{
+		"api_client": tftypes.NewValue(tftypes.String, "cli-abcde"),
+	}, &result)
+	if resets != 1 {
+		t.Errorf("expected a single reset, got %d", resets)
+	}
+	if result.Secret.ValueString() != "fresh-secret" {
+		t.Errorf("expected the new secret, got %q", result.Secret.ValueString())
+	}
+	if result.Account.ValueString() != "acc-12345" {
+		t.Errorf("expected the provider account, got %q", result.Account.ValueString())
+	}
+}
@@ -0,0 +1,108 @@
+package brightbox
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ ephemeral.EphemeralResource              = (*databaseServerPasswordEphemeralResource)(nil)
+	_ ephemeral.EphemeralResourceWithConfigure = (*databaseServerPasswordEphemeralResource)(nil)
+)
+
+// databaseServerPasswordEphemeralResource resets the admin password of
+// a database server and returns the new one without recording it in
+// state
+type databaseServerPasswordEphemeralResource struct {
+	frameworkClient
+}
+
+type databaseServerPasswordModel struct {
+	Account        types.String `tfsdk:"account"`
+	AdminPassword  types.String `tfsdk:"admin_password"`
+	AdminUsername  types.String `tfsdk:"admin_username"`
+	DatabaseServer types.String `tfsdk:"database_server"`
+}
+
+func newDatabaseServerPasswordEphemeralResource() ephemeral.EphemeralResource {
+	return &databaseServerPasswordEphemeralResource{}
+}
+
+func (r *databaseServerPasswordEphemeralResource) Metadata(
+	_ context.Context,
+	req ephemeral.MetadataRequest,
+	resp *ephemeral.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_database_server_password"
+}
+
+func (r *databaseServerPasswordEphemeralResource) Schema(
+	_ context.Context,
+	_ ephemeral.SchemaRequest,
+	resp *ephemeral.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "Resets the admin password of a Brightbox Database Server, without recording it in state",
+		Attributes: map[string]schema.Attribute{
+			"account": accountEphemeralAttribute(),
+			"admin_password": schema.StringAttribute{
+				Description: "The new password of the admin user",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"admin_username": schema.StringAttribute{
+				Description: "The username of the admin user",
+				Computed:    true,
+			},
+			"database_server": schema.StringAttribute{
+				Description: "The ID of the Database Server to reset",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(databaseServerRegexp, "must be a valid database server ID"),
+				},
+			},
+		},
+	}
+}
+
+func (r *databaseServerPasswordEphemeralResource) Configure(
+	_ context.Context,
+	req ephemeral.ConfigureRequest,
+	resp *ephemeral.ConfigureResponse,
+) {
+	r.configure(req.ProviderData, &resp.Diagnostics)
+}
+
+func (r *databaseServerPasswordEphemeralResource) Open(
+	ctx context.Context,
+	req ephemeral.OpenRequest,
+	resp *ephemeral.OpenResponse,
+) {
+	var data databaseServerPasswordModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = contextWithFrameworkLogging(ctx, "brightbox_database_server_password", data.DatabaseServer.ValueString(), "admin_password")
+	client, diags := r.forAccount(ctx, data.Account)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Resetting Database Server admin password")
+	databaseServer, err := client.APIClient.ResetDatabaseServerPassword(ctx, data.DatabaseServer.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(frameworkDiagnostics(brightboxFromErrSlice(err))...)
+		return
+	}
+	data.Account = types.StringValue(client.Account)
+	data.AdminUsername = types.StringValue(databaseServer.AdminUsername)
+	data.AdminPassword = types.StringValue(databaseServer.AdminPassword)
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
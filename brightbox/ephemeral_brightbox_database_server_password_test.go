@@ -0,0 +1,31 @@
+package brightbox
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestDatabaseServerPasswordEphemeralResource(t *testing.T) {
+	var resets int
+	client := newFakeAPIClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/1.0/database_servers/dbs-abcde/reset_password" {
+			http.NotFound(w, r)
+			return
+		}
+		resets++
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id":"dbs-abcde","admin_username":"admin","admin_password":"fresh-password"}`))
+	})
+	var result databaseServerPasswordModel
+	openEphemeralResource(t, newDatabaseServerPasswordEphemeralResource(), client, map[string]tftypes.Value{
+		"database_server": tftypes.NewValue(tftypes.String, "dbs-abcde"),
+	}, &result)
+	if resets != 1 {
+		t.Errorf("expected a single reset, got %d", resets)
+	}
+	if result.AdminUsername.ValueString() != "admin" || result.AdminPassword.ValueString() != "fresh-password" {
+		t.Errorf("expected the new admin credentials, got %q/%q", result.AdminUsername.ValueString(), result.AdminPassword.ValueString())
+	}
+}
@@ -0,0 +1,41 @@
+package brightbox
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	brightbox "github.com/brightbox/gobrightbox/v2"
+	"github.com/brightbox/gobrightbox/v2/clientcredentials"
+	"github.com/brightbox/gobrightbox/v2/endpoint"
+)
+
+// newFakeAPIClient returns a client connected to a local fake of the
+// Brightbox API. The fake issues tokens itself and passes every other
+// request to the handler.
+func newFakeAPIClient(t *testing.T, handler http.HandlerFunc) *brightbox.Client {
+	t.Helper()
+	mux := http.NewServeMux()
+	mux.HandleFunc("/token/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"access_token":"token","token_type":"Bearer","expires_in":3600}`))
+	})
+	mux.HandleFunc("/", handler)
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	ctx := contextWithLoggedHTTPClient(context.Background(), apiSubsystem)
+	client, err := brightbox.Connect(ctx, &clientcredentials.Config{
+		ID:     "cli-12345",
+		Secret: "secret",
+		Config: endpoint.Config{
+			BaseURL: server.URL + "/",
+			Scopes:  endpoint.InfrastructureScope,
+		},
+	})
+	if err != nil {
+		t.Fatalf("unable to connect to fake API: %s", err)
+	}
+	return client
+}
@@ -0,0 +1,249 @@
+package brightbox
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	fwdiag "github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	ephemeralschema "github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	fwschema "github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// frameworkProvider is the plugin framework half of the provider, muxed
+// alongside the SDKv2 Provider. It shares the provider schema and client
+// configuration with it.
+type frameworkProvider struct {
+	version string
+	// resources are the SDKv2 managed resources, which the list
+	// resources find objects for
+	resources map[string]*schema.Resource
+}
+
+var (
+	_ provider.Provider                       = (*frameworkProvider)(nil)
+	_ provider.ProviderWithActions            = (*frameworkProvider)(nil)
+	_ provider.ProviderWithEphemeralResources = (*frameworkProvider)(nil)
+	_ provider.ProviderWithFunctions          = (*frameworkProvider)(nil)
+	_ provider.ProviderWithListResources      = (*frameworkProvider)(nil)
+)
+
+// FrameworkProvider returns the plugin framework half of the Brightbox
+// Terraform driver
+func FrameworkProvider(version string) provider.Provider {
+	return &frameworkProvider{version: version, resources: Provider().ResourcesMap}
+}
+
+// ProviderServers returns both halves of the provider, ready to be muxed
+func ProviderServers(version string) []func() tfprotov5.ProviderServer {
+	sdkProvider := Provider()
+	return []func() tfprotov5.ProviderServer{
+		providerserver.NewProtocol5(&frameworkProvider{version: version, resources: sdkProvider.ResourcesMap}),
+		sdkProvider.GRPCProvider,
+	}
+}
+
+func (p *frameworkProvider) Metadata(
+	_ context.Context,
+	_ provider.MetadataRequest,
+	resp *provider.MetadataResponse,
+) {
+	resp.TypeName = "brightbox"
+	resp.Version = p.version
+}
+
+func (p *frameworkProvider) Schema(
+	_ context.Context,
+	_ provider.SchemaRequest,
+	resp *provider.SchemaResponse,
+) {
+	attributes, err := frameworkProviderAttributes(providerSchema())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid provider schema", err.Error())
+		return
+	}
+	resp.Schema = fwschema.Schema{Attributes: attributes}
+}
+
+func (p *frameworkProvider) Configure(
+	ctx context.Context,
+	req provider.ConfigureRequest,
+	resp *provider.ConfigureResponse,
+) {
+	ctx = contextWithSubsystems(ctx)
+	attributes := providerSchema()
+	values := make(map[string]interface{}, len(attributes))
+	for key, attribute := range attributes {
+		var value types.String
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(key), &value)...)
+		if value.IsUnknown() {
+			tflog.Debug(ctx, "Provider configuration not yet known", map[string]interface{}{
+				"attribute": key,
+			})
+			return
+		}
+		if !value.IsNull() {
+			values[key] = value.ValueString()
+			continue
+		}
+		defaultValue, err := attribute.DefaultValue()
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root(key), "Unable to read default", err.Error())
+			continue
+		}
+		values[key] = defaultValue
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	lookup := func(key string) interface{} { return values[key] }
+	client, diags := configureSharedClient(
+		contextWithMaskedValues(ctx, attributes, lookup),
+		providerAuthDetails(lookup),
+	)
+	resp.Diagnostics.Append(frameworkDiagnostics(diags)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.DataSourceData = client
+	resp.ResourceData = client
+	resp.EphemeralResourceData = client
+	resp.ActionData = client
+	resp.ListResourceData = client
+}
+
+func (p *frameworkProvider) Resources(_ context.Context) []func() resource.Resource {
+	return nil
+}
+
+func (p *frameworkProvider) DataSources(_ context.Context) []func() datasource.DataSource {
+	return nil
+}
+
+func (p *frameworkProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		newAPIClientSecretEphemeralResource,
+		newDatabaseServerPasswordEphemeralResource,
+	}
+}
+
+func (p *frameworkProvider) Actions(_ context.Context) []func() action.Action {
+	return []func() action.Action{
+		newDatabaseServerResetAction,
+		newServerActivateConsoleAction,
+		newServerRebootAction,
+		newServerResetAction,
+		newServerSnapshotNowAction,
+	}
+}
+
+func (p *frameworkProvider) ListResources(_ context.Context) []func() list.ListResource {
+	return listResources(p.resources)
+}
+
+func (p *frameworkProvider) Functions(_ context.Context) []func() function.Function {
+	return []func() function.Function{
+		newIPv6HostnameFunction,
+		newParseIDFunction,
+		newServerFQDNFunction,
+		newUserdataEncodeFunction,
+	}
+}
+
+// frameworkClient holds the Composite client the provider passes to a
+// plugin framework resource when it is configured
+type frameworkClient struct {
+	client *CompositeClient
+}
+
+// configure stores the provider data, which is nil until the provider
+// itself has been configured
+func (f *frameworkClient) configure(providerData any, diags *fwdiag.Diagnostics) {
+	if providerData == nil {
+		return
+	}
+	client, ok := providerData.(*CompositeClient)
+	if !ok {
+		diags.AddError(
+			"Unexpected provider data",
+			fmt.Sprintf("expected *CompositeClient, got %T", providerData),
+		)
+		return
+	}
+	f.client = client
+}
+
+// forAccount returns the Composite client for account, falling back to
+// the provider account when it is null or empty
+func (f *frameworkClient) forAccount(ctx context.Context, account types.String) (*CompositeClient, fwdiag.Diagnostics) {
+	if f.client == nil {
+		var diags fwdiag.Diagnostics
+		diags.AddError("Provider not configured", "The Brightbox provider has not been configured")
+		return nil, diags
+	}
+	client, diags := f.client.ForAccount(ctx, account.ValueString())
+	return client, frameworkDiagnostics(diags)
+}
+
+// accountEphemeralAttribute is the optional account override of an
+// ephemeral resource
+func accountEphemeralAttribute() ephemeralschema.StringAttribute {
+	return ephemeralschema.StringAttribute{
+		Description: "The account to operate upon, if different from the provider account",
+		Optional:    true,
+		Computed:    true,
+		Validators: []validator.String{
+			stringvalidator.RegexMatches(accountRegexp, "must be a valid account ID"),
+		},
+	}
+}
+
+// frameworkProviderAttributes converts the SDKv2 provider schema into
+// plugin framework attributes, so both halves serve the same schema
+func frameworkProviderAttributes(
+	attributes map[string]*schema.Schema,
+) (map[string]fwschema.Attribute, error) {
+	result := make(map[string]fwschema.Attribute, len(attributes))
+	for key, attribute := range attributes {
+		// Configure reads every attribute as a string
+		if attribute.Type != schema.TypeString {
+			return nil, fmt.Errorf("provider attribute %q has unsupported type %s", key, attribute.Type)
+		}
+		result[key] = fwschema.StringAttribute{
+			Description: attribute.Description,
+			Required:    attribute.Required,
+			Optional:    attribute.Optional,
+			Sensitive:   attribute.Sensitive,
+		}
+	}
+	return result, nil
+}
+
+// frameworkDiagnostics converts SDKv2 diagnostics into plugin framework
+// diagnostics
+func frameworkDiagnostics(diags diag.Diagnostics) fwdiag.Diagnostics {
+	var result fwdiag.Diagnostics
+	for _, d := range diags {
+		if d.Severity == diag.Error {
+			result.AddError(d.Summary, d.Detail)
+		} else {
+			result.AddWarning(d.Summary, d.Detail)
+		}
+	}
+	return result
+}
@@ -0,0 +1,213 @@
+package brightbox
+
+import (
+	"context"
+	"testing"
+
+	brightbox "github.com/brightbox/gobrightbox/v2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	fwdiag "github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-mux/tf5muxserver"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// testAccProtoV5ProviderFactories serves both halves of the provider
+// through the mux, as main does
+func testAccProtoV5ProviderFactories() map[string]func() (tfprotov5.ProviderServer, error) {
+	return map[string]func() (tfprotov5.ProviderServer, error){
+		"brightbox": func() (tfprotov5.ProviderServer, error) {
+			muxServer, err := tf5muxserver.NewMuxServer(context.Background(), ProviderServers("test")...)
+			if err != nil {
+				return nil, err
+			}
+			return muxServer.ProviderServer(), nil
+		},
+	}
+}
+
+func providerSchemaFrom(t *testing.T, server tfprotov5.ProviderServer) *tfprotov5.Schema {
+	t.Helper()
+	resp, err := server.GetProviderSchema(context.Background(), &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	for _, d := range resp.Diagnostics {
+		if d.Severity == tfprotov5.DiagnosticSeverityError {
+			t.Fatalf("unexpected error: %s: %s", d.Summary, d.Detail)
+		}
+	}
+	return resp.Provider
+}
+
+func TestFrameworkProviderSchemaMatchesSDK(t *testing.T) {
+	sdkSchema := providerSchemaFrom(t, Provider().GRPCProvider())
+	frameworkSchema := providerSchemaFrom(t, providerserver.NewProtocol5(FrameworkProvider("test"))())
+	if diff := cmp.Diff(sdkSchema, frameworkSchema); diff != "" {
+		t.Errorf("provider schemas differ (-sdk +framework):\n%s", diff)
+	}
+}
+
+func TestMuxedProviderSchema(t *testing.T) {
+	server, err := testAccProtoV5ProviderFactories()["brightbox"]()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	muxedSchema := providerSchemaFrom(t, server)
+	if len(muxedSchema.Block.Attributes) != len(providerSchema()) {
+		t.Errorf("expected %d provider attributes, got %d", len(providerSchema()), len(muxedSchema.Block.Attributes))
+	}
+	resp, err := server.GetProviderSchema(context.Background(), &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if _, ok := resp.ResourceSchemas["brightbox_server"]; !ok {
+		t.Error("expected SDKv2 resources to be served through the mux")
+	}
+}
+
+func TestFrameworkProviderAttributesRejectsNonString(t *testing.T) {
+	_, err := frameworkProviderAttributes(map[string]*schema.Schema{
+		"retries": {Type: schema.TypeInt, Optional: true},
+	})
+	if err == nil {
+		t.Error("expected an error for a non-string provider attribute")
+	}
+}
+
+// openEphemeralResource configures r with a client for the fake API and
+// opens it with the given configuration values, storing the result in
+// target. Attributes missing from config are null.
+func openEphemeralResource(
+	t *testing.T,
+	r ephemeral.EphemeralResource,
+	apiClient *brightbox.Client,
+	config map[string]tftypes.Value,
+	target interface{},
+) {
+	t.Helper()
+	ctx := context.Background()
+	var schemaResp ephemeral.SchemaResponse
+	r.Schema(ctx, ephemeral.SchemaRequest{}, &schemaResp)
+	var configureResp ephemeral.ConfigureResponse
+	r.(ephemeral.EphemeralResourceWithConfigure).Configure(ctx, ephemeral.ConfigureRequest{
+		ProviderData: &CompositeClient{APIClient: apiClient, Account: "acc-12345"},
+	}, &configureResp)
+	if configureResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", configureResp.Diagnostics)
+	}
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for key, attributeType := range objectType.AttributeTypes {
+		if value, ok := config[key]; ok {
+			values[key] = value
+		} else {
+			values[key] = tftypes.NewValue(attributeType, nil)
+		}
+	}
+	raw := tftypes.NewValue(objectType, values)
+	resp := ephemeral.OpenResponse{
+		Result: tfsdk.EphemeralResultData{Schema: schemaResp.Schema, Raw: raw},
+	}
+	r.Open(ctx, ephemeral.OpenRequest{
+		Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: raw},
+	}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+	if diags := resp.Result.Get(ctx, target); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+}
+
+// callFunction calls a provider function through the framework provider
+// server, returning its string result
+func callFunction(t *testing.T, name string, arguments ...string) (string, *tfprotov5.FunctionError) {
+	t.Helper()
+	server := providerserver.NewProtocol5(FrameworkProvider("test"))()
+	values := make([]*tfprotov5.DynamicValue, len(arguments))
+	for i, argument := range arguments {
+		value, err := tfprotov5.NewDynamicValue(tftypes.String, tftypes.NewValue(tftypes.String, argument))
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		values[i] = &value
+	}
+	resp, err := server.CallFunction(context.Background(), &tfprotov5.CallFunctionRequest{
+		Name:      name,
+		Arguments: values,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if resp.Error != nil {
+		return "", resp.Error
+	}
+	result, err := resp.Result.Unmarshal(tftypes.String)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	var text string
+	if err := result.As(&text); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	return text, nil
+}
+
+// invokeAction configures a with a client for the fake API and invokes
+// it with the given configuration values, returning its diagnostics and
+// progress messages. Attributes missing from config are null.
+func invokeAction(
+	t *testing.T,
+	a action.Action,
+	apiClient *brightbox.Client,
+	config map[string]tftypes.Value,
+) (fwdiag.Diagnostics, []string) {
+	t.Helper()
+	return invokeActionContext(context.Background(), t, a, apiClient, config)
+}
+
+// invokeActionContext is invokeAction with the context, and so the
+// logger, supplied
+func invokeActionContext(
+	ctx context.Context,
+	t *testing.T,
+	a action.Action,
+	apiClient *brightbox.Client,
+	config map[string]tftypes.Value,
+) (fwdiag.Diagnostics, []string) {
+	t.Helper()
+	var schemaResp action.SchemaResponse
+	a.Schema(ctx, action.SchemaRequest{}, &schemaResp)
+	var configureResp action.ConfigureResponse
+	a.(action.ActionWithConfigure).Configure(ctx, action.ConfigureRequest{
+		ProviderData: &CompositeClient{APIClient: apiClient, Account: "acc-12345"},
+	}, &configureResp)
+	if configureResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", configureResp.Diagnostics)
+	}
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for key, attributeType := range objectType.AttributeTypes {
+		if value, ok := config[key]; ok {
+			values[key] = value
+		} else {
+			values[key] = tftypes.NewValue(attributeType, nil)
+		}
+	}
+	var messages []string
+	resp := action.InvokeResponse{
+		SendProgress: func(event action.InvokeProgressEvent) {
+			messages = append(messages, event.Message)
+		},
+	}
+	a.Invoke(ctx, action.InvokeRequest{
+		Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)},
+	}, &resp)
+	return resp.Diagnostics, messages
+}
@@ -0,0 +1,61 @@
+package brightbox
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = (*parseIDFunction)(nil)
+
+// parseIDFunction returns the kind of object a Brightbox ID identifies
+type parseIDFunction struct{}
+
+func newParseIDFunction() function.Function {
+	return &parseIDFunction{}
+}
+
+func (f *parseIDFunction) Metadata(
+	_ context.Context,
+	_ function.MetadataRequest,
+	resp *function.MetadataResponse,
+) {
+	resp.Name = "parse_id"
+}
+
+func (f *parseIDFunction) Definition(
+	_ context.Context,
+	_ function.DefinitionRequest,
+	resp *function.DefinitionResponse,
+) {
+	resp.Definition = function.Definition{
+		Summary:     "Kind of object a Brightbox ID identifies",
+		Description: "Returns the kind of object identified by a Brightbox ID, such as server for srv-xxxxx or server_group for grp-xxxxx",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "id",
+				Description: "The Brightbox ID to parse",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *parseIDFunction) Run(
+	ctx context.Context,
+	req function.RunRequest,
+	resp *function.RunResponse,
+) {
+	var id string
+	resp.Error = req.Arguments.Get(ctx, &id)
+	if resp.Error != nil {
+		return
+	}
+	kind, ok := idKind(id)
+	if !ok {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("%q is not a recognised Brightbox ID", id))
+		return
+	}
+	resp.Error = resp.Result.Set(ctx, kind)
+}
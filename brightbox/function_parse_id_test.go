@@ -0,0 +1,48 @@
+package brightbox
+
+import (
+	"testing"
+)
+
+func TestParseIDFunction(t *testing.T) {
+	testCases := []struct {
+		id   string
+		kind string
+	}{
+		{"srv-abcde", "server"},
+		{"grp-abcde", "server_group"},
+		{"cip-abcde", "cloudip"},
+		{"dbs-abcde", "database_server"},
+		{"lba-abcde", "load_balancer"},
+		{"zon-abcde", "zone"},
+		{"cli-abcde", "api_client"},
+	}
+	for _, tcase := range testCases {
+		result, funcErr := callFunction(t, "parse_id", tcase.id)
+		if funcErr != nil {
+			t.Errorf("%s: unexpected error: %s", tcase.id, funcErr.Text)
+			continue
+		}
+		if result != tcase.kind {
+			t.Errorf("%s: expected %q, got %q", tcase.id, tcase.kind, result)
+		}
+	}
+	for _, id := range []string{"srv-abc", "xyz-abcde", "gb1-a", ""} {
+		if _, funcErr := callFunction(t, "parse_id", id); funcErr == nil {
+			t.Errorf("%q: expected an error", id)
+		}
+	}
+}
+
+func TestIDKindsCoverEveryPattern(t *testing.T) {
+	seen := make(map[string]bool)
+	for _, entry := range idKinds {
+		if seen[entry.kind] {
+			t.Errorf("kind %q listed more than once", entry.kind)
+		}
+		seen[entry.kind] = true
+		if kind, _ := idKind(entry.pattern.String()[1:4] + "-abcde"); kind != entry.kind {
+			t.Errorf("%s: expected kind %q, got %q", entry.pattern, entry.kind, kind)
+		}
+	}
+}
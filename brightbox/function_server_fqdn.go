@@ -0,0 +1,144 @@
+package brightbox
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// The domain of servers in the default region
+const defaultServerDomain = "gb1.brightbox.com"
+
+var (
+	_ function.Function = (*serverFQDNFunction)(nil)
+	_ function.Function = (*ipv6HostnameFunction)(nil)
+)
+
+// serverFQDNFunction builds the fully qualified domain name of a server
+// from its ID
+type serverFQDNFunction struct{}
+
+func newServerFQDNFunction() function.Function {
+	return &serverFQDNFunction{}
+}
+
+func (f *serverFQDNFunction) Metadata(
+	_ context.Context,
+	_ function.MetadataRequest,
+	resp *function.MetadataResponse,
+) {
+	resp.Name = "server_fqdn"
+}
+
+func (f *serverFQDNFunction) Definition(
+	_ context.Context,
+	_ function.DefinitionRequest,
+	resp *function.DefinitionResponse,
+) {
+	resp.Definition = serverNameDefinition(
+		"Fully qualified domain name of a server",
+		"Returns the fully qualified domain name of a server, which resolves to its private IPv4 address",
+	)
+}
+
+func (f *serverFQDNFunction) Run(
+	ctx context.Context,
+	req function.RunRequest,
+	resp *function.RunResponse,
+) {
+	fqdn, funcErr := serverFQDNArgument(ctx, req)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+	resp.Error = resp.Result.Set(ctx, fqdn)
+}
+
+// ipv6HostnameFunction builds the public IPv6 hostname of a server from
+// its ID
+type ipv6HostnameFunction struct{}
+
+func newIPv6HostnameFunction() function.Function {
+	return &ipv6HostnameFunction{}
+}
+
+func (f *ipv6HostnameFunction) Metadata(
+	_ context.Context,
+	_ function.MetadataRequest,
+	resp *function.MetadataResponse,
+) {
+	resp.Name = "ipv6_hostname"
+}
+
+func (f *ipv6HostnameFunction) Definition(
+	_ context.Context,
+	_ function.DefinitionRequest,
+	resp *function.DefinitionResponse,
+) {
+	resp.Definition = serverNameDefinition(
+		"Public IPv6 hostname of a server",
+		"Returns the public IPv6 hostname of a server, as exported by its ipv6_hostname attribute",
+	)
+}
+
+func (f *ipv6HostnameFunction) Run(
+	ctx context.Context,
+	req function.RunRequest,
+	resp *function.RunResponse,
+) {
+	fqdn, funcErr := serverFQDNArgument(ctx, req)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+	resp.Error = resp.Result.Set(ctx, "ipv6."+fqdn)
+}
+
+// serverNameDefinition is the definition shared by the functions that
+// build names from a server ID and an optional domain
+func serverNameDefinition(summary string, description string) function.Definition {
+	return function.Definition{
+		Summary:     summary,
+		Description: description,
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "server_id",
+				Description: "The ID of the server",
+			},
+		},
+		VariadicParameter: function.StringParameter{
+			Name:        "domain",
+			Description: fmt.Sprintf("The domain of the server's region. Defaults to %s", defaultServerDomain),
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// serverFQDNArgument builds a server FQDN from the function arguments
+func serverFQDNArgument(ctx context.Context, req function.RunRequest) (string, *function.FuncError) {
+	var id string
+	var domains []string
+	if funcErr := req.Arguments.Get(ctx, &id, &domains); funcErr != nil {
+		return "", funcErr
+	}
+	if !serverRegexp.MatchString(id) {
+		return "", function.NewArgumentFuncError(0, fmt.Sprintf("%q is not a valid server ID", id))
+	}
+	switch len(domains) {
+	case 0:
+		return serverFQDN(id, defaultServerDomain), nil
+	case 1:
+		if !dnsNameRegexp.MatchString(domains[0]) {
+			return "", function.NewArgumentFuncError(1, fmt.Sprintf("%q is not a valid domain name", domains[0]))
+		}
+		return serverFQDN(id, domains[0]), nil
+	default:
+		return "", function.NewArgumentFuncError(2, "at most one domain may be given")
+	}
+}
+
+// serverFQDN returns the fully qualified domain name of a server
+func serverFQDN(id string, domain string) string {
+	return id + "." + domain
+}
@@ -0,0 +1,45 @@
+package brightbox
+
+import (
+	"testing"
+)
+
+func TestServerNameFunctions(t *testing.T) {
+	testCases := []struct {
+		function  string
+		arguments []string
+		expected  string
+	}{
+		{"server_fqdn", []string{"srv-abcde"}, "srv-abcde.gb1.brightbox.com"},
+		{"server_fqdn", []string{"srv-abcde", "gb1s.brightbox.com"}, "srv-abcde.gb1s.brightbox.com"},
+		{"ipv6_hostname", []string{"srv-abcde"}, "ipv6.srv-abcde.gb1.brightbox.com"},
+		{"ipv6_hostname", []string{"srv-abcde", "gb1s.brightbox.com"}, "ipv6.srv-abcde.gb1s.brightbox.com"},
+	}
+	for _, tcase := range testCases {
+		result, funcErr := callFunction(t, tcase.function, tcase.arguments...)
+		if funcErr != nil {
+			t.Errorf("%s%v: unexpected error: %s", tcase.function, tcase.arguments, funcErr.Text)
+			continue
+		}
+		if result != tcase.expected {
+			t.Errorf("%s%v: expected %q, got %q", tcase.function, tcase.arguments, tcase.expected, result)
+		}
+	}
+}
+
+func TestServerNameFunctionErrors(t *testing.T) {
+	testCases := []struct {
+		function  string
+		arguments []string
+	}{
+		{"server_fqdn", []string{"grp-abcde"}},
+		{"server_fqdn", []string{"srv-abcde", "not a domain"}},
+		{"server_fqdn", []string{"srv-abcde", "gb1.brightbox.com", "gb1s.brightbox.com"}},
+		{"ipv6_hostname", []string{"srv-abc"}},
+	}
+	for _, tcase := range testCases {
+		if _, funcErr := callFunction(t, tcase.function, tcase.arguments...); funcErr == nil {
+			t.Errorf("%s%v: expected an error", tcase.function, tcase.arguments)
+		}
+	}
+}
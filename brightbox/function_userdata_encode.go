@@ -0,0 +1,89 @@
+package brightbox
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = (*userdataEncodeFunction)(nil)
+
+// userdataEncodeFunction compresses and encodes a cloud-init payload
+// ready for user_data_base64
+type userdataEncodeFunction struct{}
+
+func newUserdataEncodeFunction() function.Function {
+	return &userdataEncodeFunction{}
+}
+
+func (f *userdataEncodeFunction) Metadata(
+	_ context.Context,
+	_ function.MetadataRequest,
+	resp *function.MetadataResponse,
+) {
+	resp.Name = "userdata_encode"
+}
+
+func (f *userdataEncodeFunction) Definition(
+	_ context.Context,
+	_ function.DefinitionRequest,
+	resp *function.DefinitionResponse,
+) {
+	resp.Definition = function.Definition{
+		Summary:     "Compress and encode user data",
+		Description: fmt.Sprintf("Gzips and base64 encodes a cloud-init payload for the user_data_base64 argument of a server, failing if the result exceeds the %d byte limit", userdataSizeLimit),
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "user_data",
+				Description: "The user data to encode",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *userdataEncodeFunction) Run(
+	ctx context.Context,
+	req function.RunRequest,
+	resp *function.RunResponse,
+) {
+	var userData string
+	resp.Error = req.Arguments.Get(ctx, &userData)
+	if resp.Error != nil {
+		return
+	}
+	encoded, err := gzipBase64Encode(userData)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+	if len(encoded) > userdataSizeLimit {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf(
+			"The supplied user_data contains %d bytes after encoding, this exceeds the limit of %d bytes",
+			len(encoded),
+			userdataSizeLimit,
+		))
+		return
+	}
+	resp.Error = resp.Result.Set(ctx, encoded)
+}
+
+// gzipBase64Encode compresses data and encodes it in base64
+func gzipBase64Encode(data string) (string, error) {
+	var buffer bytes.Buffer
+	writer, err := gzip.NewWriterLevel(&buffer, gzip.BestCompression)
+	if err != nil {
+		return "", err
+	}
+	if _, err := writer.Write([]byte(data)); err != nil {
+		return "", err
+	}
+	if err := writer.Close(); err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(buffer.Bytes()), nil
+}
@@ -0,0 +1,58 @@
+package brightbox
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"io"
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+func TestUserdataEncodeFunction(t *testing.T) {
+	const userData = "#cloud-config\npackages:\n  - nginx\n"
+	result, funcErr := callFunction(t, "userdata_encode", userData)
+	if funcErr != nil {
+		t.Fatalf("unexpected error: %s", funcErr.Text)
+	}
+	compressed, err := base64.StdEncoding.DecodeString(result)
+	if err != nil {
+		t.Fatalf("result is not base64: %s", err)
+	}
+	reader, err := gzip.NewReader(bytes.NewReader(compressed))
+	if err != nil {
+		t.Fatalf("result is not gzipped: %s", err)
+	}
+	decoded, err := io.ReadAll(reader)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if string(decoded) != userData {
+		t.Errorf("expected %q, got %q", userData, decoded)
+	}
+	again, _ := callFunction(t, "userdata_encode", userData)
+	if again != result {
+		t.Error("expected the encoding to be stable")
+	}
+}
+
+func TestUserdataEncodeFunctionCompresses(t *testing.T) {
+	userData := strings.Repeat("#cloud-config\n", userdataSizeLimit/4)
+	if _, funcErr := callFunction(t, "userdata_encode", userData); funcErr != nil {
+		t.Errorf("unexpected error: %s", funcErr.Text)
+	}
+}
+
+func TestUserdataEncodeFunctionSizeLimit(t *testing.T) {
+	random := make([]byte, userdataSizeLimit)
+	rand.New(rand.NewSource(1)).Read(random)
+	userData := base64.StdEncoding.EncodeToString(random)
+	_, funcErr := callFunction(t, "userdata_encode", userData)
+	if funcErr == nil {
+		t.Fatal("expected an error for user data over the size limit")
+	}
+	if !strings.Contains(funcErr.Text, "exceeds the limit") {
+		t.Errorf("unexpected error: %s", funcErr.Text)
+	}
+}
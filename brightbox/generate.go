@@ -0,0 +1,339 @@
+package brightbox
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// generateTypes are the managed resources the generate command writes
+// configuration for, in the order they are listed
+var generateTypes = []string{
+	"brightbox_server_group",
+	"brightbox_firewall_policy",
+	"brightbox_firewall_rule",
+	"brightbox_server",
+	"brightbox_volume",
+	"brightbox_cloudip",
+	"brightbox_load_balancer",
+	"brightbox_database_server",
+	"brightbox_config_map",
+	"brightbox_orbit_container",
+}
+
+// generateSkipped lists the attributes whose state does not hold the
+// value to configure
+var generateSkipped = map[string][]string{
+	// The state holds a hash of the user data
+	"brightbox_server": {"user_data"},
+}
+
+// generateSensitive lists the Sensitive attributes written all the
+// same, because the state holds the value and leaving it out would
+// clear it from the object on the first apply
+var generateSensitive = map[string][]string{
+	// An imported server records its user data here
+	"brightbox_server": {"user_data_base64"},
+}
+
+// generateLiteral lists the attributes always written as literal IDs,
+// because a reference would form a cycle
+var generateLiteral = map[string][]string{
+	// The boot volume refers back to its server
+	"brightbox_server": {"volume"},
+}
+
+// generateReferences lists the attributes, other than id, that other
+// objects refer to
+var generateReferences = map[string][]string{
+	// Cloud IPs are mapped to the server interface
+	"brightbox_server": {"interface"},
+}
+
+var generateNameRegexp = regexp.MustCompile("[^a-z0-9]+")
+
+// generatedObject is an object imported for the generate command
+type generatedObject struct {
+	typeName string
+	name     string
+	d        *schema.ResourceData
+}
+
+// Generate writes Terraform configuration, with import blocks, for the
+// objects in an account into dir. It authenticates with the same
+// environment variables as the provider, and reports progress to out.
+func Generate(ctx context.Context, dir string, out io.Writer) error {
+	ctx = contextWithSubsystems(ctx)
+	client, diags := obtainCloudClient()
+	reportWarnings(out, diags)
+	if diags.HasError() {
+		return diagnosticsError(diags)
+	}
+	return generateConfiguration(ctx, client, dir, out)
+}
+
+// generateConfiguration imports every object the client can see, then
+// writes a file of resource and import blocks for each managed resource
+// type into dir. References between the objects are written as
+// expressions, so Terraform orders them correctly.
+func generateConfiguration(ctx context.Context, client *CompositeClient, dir string, out io.Writer) error {
+	resources := Provider().ResourcesMap
+	used := make(map[string]bool)
+	references := make(map[string]hcl.Traversal)
+	objects := make(map[string][]generatedObject)
+	for _, typeName := range generateTypes {
+		if typeName == "brightbox_orbit_container" && client.OrbitClient == nil {
+			fmt.Fprintf(out, "Warning: skipping %s: %s\n", typeName, errOrbitUnavailable)
+			continue
+		}
+		resource := resources[typeName]
+		importer := listResourceTypes[typeName](resource).(objectImporter)
+		importIDs, err := importer.importIDs(ctx, client)
+		if err != nil {
+			return fmt.Errorf("listing %s: %w", typeName, err)
+		}
+		for _, importID := range importIDs {
+			d, diags := importObject(ctx, resource, client, importID, true)
+			reportWarnings(out, diags)
+			if diags.HasError() {
+				return fmt.Errorf("importing %s %s: %w", typeName, importID, diagnosticsError(diags))
+			}
+			if d == nil {
+				continue
+			}
+			object := generatedObject{
+				typeName: typeName,
+				name:     generateName(typeName, d, used),
+				d:        d,
+			}
+			references[d.Id()] = generateTraversal(object, "id")
+			for _, key := range generateReferences[typeName] {
+				if value, ok := d.GetOk(key); ok {
+					references[value.(string)] = generateTraversal(object, key)
+				}
+			}
+			objects[typeName] = append(objects[typeName], object)
+		}
+	}
+	for _, typeName := range generateTypes {
+		if len(objects[typeName]) == 0 {
+			continue
+		}
+		filename := filepath.Join(dir, typeName+".tf")
+		if err := writeGeneratedFile(filename, resources[typeName], objects[typeName], references); err != nil {
+			return err
+		}
+		fmt.Fprintf(out, "Wrote %d %s to %s\n", len(objects[typeName]), typeName, filename)
+	}
+	return nil
+}
+
+// reportWarnings writes the warning diagnostics to out
+func reportWarnings(out io.Writer, diags diag.Diagnostics) {
+	for _, d := range diags {
+		if d.Severity == diag.Warning {
+			fmt.Fprintf(out, "Warning: %s\n", d.Summary)
+		}
+	}
+}
+
+// generateName returns a unique Terraform name for the object in d,
+// taken from its name if it has one and from its ID if not
+func generateName(typeName string, d *schema.ResourceData, used map[string]bool) string {
+	sanitise := func(value string) string {
+		return strings.Trim(generateNameRegexp.ReplaceAllString(strings.ToLower(value), "_"), "_")
+	}
+	name, _ := d.Get("name").(string)
+	result := sanitise(name)
+	if result == "" || (result[0] >= '0' && result[0] <= '9') {
+		result = sanitise(d.Id())
+	}
+	if used[typeName+"."+result] {
+		result = result + "_" + sanitise(d.Id())
+	}
+	used[typeName+"."+result] = true
+	return result
+}
+
+func generateTraversal(object generatedObject, attribute string) hcl.Traversal {
+	return hcl.Traversal{
+		hcl.TraverseRoot{Name: object.typeName},
+		hcl.TraverseAttr{Name: object.name},
+		hcl.TraverseAttr{Name: attribute},
+	}
+}
+
+// writeGeneratedFile writes the resource and import blocks of objects
+// to filename, which must not already exist
+func writeGeneratedFile(
+	filename string,
+	resource *schema.Resource,
+	objects []generatedObject,
+	references map[string]hcl.Traversal,
+) error {
+	file := hclwrite.NewEmptyFile()
+	body := file.Body()
+	for i, object := range objects {
+		if i > 0 {
+			body.AppendNewline()
+		}
+		block := body.AppendNewBlock("resource", []string{object.typeName, object.name})
+		writeGeneratedAttributes(
+			block.Body(),
+			resource.Schema,
+			object.d.Get,
+			generateSkipped[object.typeName],
+			generateSensitive[object.typeName],
+			generateLiteral[object.typeName],
+			references,
+		)
+		body.AppendNewline()
+		importBlock := body.AppendNewBlock("import", nil).Body()
+		importBlock.SetAttributeTraversal("to", hcl.Traversal{
+			hcl.TraverseRoot{Name: object.typeName},
+			hcl.TraverseAttr{Name: object.name},
+		})
+		importBlock.SetAttributeValue("id", cty.StringVal(object.d.Id()))
+	}
+	f, err := os.OpenFile(filename, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o644)
+	if err != nil {
+		return err
+	}
+	if _, err := file.WriteTo(f); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// writeGeneratedAttributes writes the configurable attributes and blocks
+// of schemaMap that get returns a value for, leaving out Sensitive ones
+// unless listed in sensitive. Values holding the ID of another imported
+// object are written as references to it.
+func writeGeneratedAttributes(
+	body *hclwrite.Body,
+	schemaMap map[string]*schema.Schema,
+	get func(string) interface{},
+	skipped []string,
+	sensitive []string,
+	literal []string,
+	references map[string]hcl.Traversal,
+) {
+	keys := make([]string, 0, len(schemaMap))
+	for key := range schemaMap {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	written := make(map[string]bool)
+	for _, key := range keys {
+		attribute := schemaMap[key]
+		if (!attribute.Required && !attribute.Optional) ||
+			attribute.WriteOnly || (attribute.Sensitive && !slices.Contains(sensitive, key)) || attribute.Deprecated != "" ||
+			key == accountAttribute || slices.Contains(skipped, key) {
+			continue
+		}
+		conflicting := append(append([]string(nil), attribute.ConflictsWith...), attribute.ExactlyOneOf...)
+		if slices.ContainsFunc(conflicting, func(other string) bool { return written[other] }) {
+			continue
+		}
+		value := get(key)
+		if attribute.Default != nil && value == attribute.Default {
+			continue
+		}
+		if elem, ok := attribute.Elem.(*schema.Resource); ok {
+			for _, item := range generateElements(value) {
+				fields := item.(map[string]interface{})
+				nested := body.AppendNewBlock(key, nil).Body()
+				writeGeneratedAttributes(nested, elem.Schema, func(k string) interface{} { return fields[k] }, nil, nil, nil, references)
+				written[key] = true
+			}
+			continue
+		}
+		elementReferences := references
+		if slices.Contains(literal, key) {
+			elementReferences = nil
+		}
+		tokens, ok := generateTokens(attribute, value, elementReferences)
+		if !ok && !attribute.Required {
+			continue
+		}
+		body.SetAttributeRaw(key, tokens)
+		written[key] = true
+	}
+}
+
+// generateElements returns the elements of a list or set value, with
+// set elements in a stable order
+func generateElements(value interface{}) []interface{} {
+	switch v := value.(type) {
+	case *schema.Set:
+		elements := v.List()
+		sort.SliceStable(elements, func(i, j int) bool {
+			return fmt.Sprint(elements[i]) < fmt.Sprint(elements[j])
+		})
+		return elements
+	case []interface{}:
+		return v
+	}
+	return nil
+}
+
+// generateTokens returns the expression for value, and false if it is
+// empty
+func generateTokens(
+	attribute *schema.Schema,
+	value interface{},
+	references map[string]hcl.Traversal,
+) (hclwrite.Tokens, bool) {
+	switch attribute.Type {
+	case schema.TypeString:
+		v, _ := value.(string)
+		if traversal, ok := references[v]; ok && v != "" {
+			return hclwrite.TokensForTraversal(traversal), true
+		}
+		return hclwrite.TokensForValue(cty.StringVal(v)), v != ""
+	case schema.TypeInt:
+		v, _ := value.(int)
+		return hclwrite.TokensForValue(cty.NumberIntVal(int64(v))), v != 0
+	case schema.TypeFloat:
+		v, _ := value.(float64)
+		return hclwrite.TokensForValue(cty.NumberFloatVal(v)), v != 0
+	case schema.TypeBool:
+		v, _ := value.(bool)
+		return hclwrite.TokensForValue(cty.BoolVal(v)), v
+	case schema.TypeMap:
+		v, _ := value.(map[string]interface{})
+		values := make(map[string]cty.Value, len(v))
+		for key, item := range v {
+			values[key] = cty.StringVal(fmt.Sprint(item))
+		}
+		if len(values) == 0 {
+			return hclwrite.TokensForValue(cty.MapValEmpty(cty.String)), false
+		}
+		return hclwrite.TokensForValue(cty.MapVal(values)), true
+	case schema.TypeList, schema.TypeSet:
+		elem, _ := attribute.Elem.(*schema.Schema)
+		if elem == nil {
+			return nil, false
+		}
+		var items []hclwrite.Tokens
+		for _, item := range generateElements(value) {
+			tokens, _ := generateTokens(elem, item, references)
+			items = append(items, tokens)
+		}
+		return hclwrite.TokensForTuple(items), len(items) > 0
+	}
+	return nil, false
+}
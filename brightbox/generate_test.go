@@ -0,0 +1,141 @@
+package brightbox
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+// generateAPI is a fake account holding a server with user data in a
+// group, with a mapped Cloud IP and a firewall policy for the group
+var generateAPI = map[string]string{
+	"/1.0/server_groups": `[{"id":"grp-aaaaa","name":"Web Servers","servers":[{"id":"srv-aaaaa"}]}]`,
+	"/1.0/server_groups/grp-aaaaa": `{"id":"grp-aaaaa","name":"Web Servers","description":"web",
+		"servers":[{"id":"srv-aaaaa"}],"firewall_policy":{"id":"fwp-aaaaa"}}`,
+	"/1.0/firewall_policies":           `[{"id":"fwp-aaaaa","name":"web","server_group":{"id":"grp-aaaaa"}}]`,
+	"/1.0/firewall_policies/fwp-aaaaa": `{"id":"fwp-aaaaa","name":"web","server_group":{"id":"grp-aaaaa"},"rules":[{"id":"fwr-aaaaa"}]}`,
+	"/1.0/firewall_rules":              `[{"id":"fwr-aaaaa"}]`,
+	"/1.0/firewall_rules/fwr-aaaaa": `{"id":"fwr-aaaaa","protocol":"tcp","destination_port":"80",
+		"source":"grp-aaaaa","firewall_policy":{"id":"fwp-aaaaa"}}`,
+	"/1.0/servers": `[{"id":"srv-aaaaa","name":"web-1","status":"active"}]`,
+	"/1.0/servers/srv-aaaaa": `{"id":"srv-aaaaa","name":"web-1","status":"active","hostname":"srv-aaaaa",
+		"user_data":"IyEvYmluL3NoCmVjaG8gaGVsbG8K",
+		"image":{"id":"img-aaaaa","username":"ubuntu"},
+		"server_type":{"id":"typ-aaaaa","handle":"1gb.ssd","disk_size":30720},
+		"zone":{"id":"zon-aaaaa","handle":"gb1-a"},
+		"interfaces":[{"id":"int-aaaaa","ipv4_address":"10.0.0.1"}],
+		"server_groups":[{"id":"grp-aaaaa"}],
+		"volumes":[{"id":"vol-aaaaa","boot":true,"size":30720}]}`,
+	"/1.0/volumes":   `[]`,
+	"/1.0/cloud_ips": `[{"id":"cip-aaaaa","name":"web","status":"mapped"}]`,
+	"/1.0/cloud_ips/cip-aaaaa": `{"id":"cip-aaaaa","name":"web","status":"mapped",
+		"public_ip":"109.107.50.1","interface":{"id":"int-aaaaa"},"server":{"id":"srv-aaaaa"}}`,
+	"/1.0/load_balancers":   `[]`,
+	"/1.0/database_servers": `[]`,
+	"/1.0/config_maps":      `[]`,
+}
+
+var generateUserDataRegexp = regexp.MustCompile(`(?m)^\s*user_data\s+=`)
+
+func generateHandler(t *testing.T) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		body, ok := generateAPI[r.URL.Path]
+		if r.Method != http.MethodGet || !ok {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			http.NotFound(w, r)
+			return
+		}
+		w.Write([]byte(body))
+	}
+}
+
+func TestGenerateConfiguration(t *testing.T) {
+	dir := t.TempDir()
+	client := &CompositeClient{
+		APIClient: newFakeAPIClient(t, generateHandler(t)),
+		Account:   "acc-12345",
+	}
+	var out bytes.Buffer
+	if err := generateConfiguration(context.Background(), client, dir, &out); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !strings.Contains(out.String(), "skipping brightbox_orbit_container") {
+		t.Errorf("expected Orbit to be skipped, got %q", out.String())
+	}
+	testCases := []struct {
+		filename string
+		expected []string
+	}{
+		{"brightbox_server_group.tf", []string{
+			`resource "brightbox_server_group" "web_servers" {`,
+			`description = "web"`,
+			"to = brightbox_server_group.web_servers",
+			`id = "grp-aaaaa"`,
+		}},
+		{"brightbox_firewall_policy.tf", []string{
+			"server_group = brightbox_server_group.web_servers.id",
+		}},
+		{"brightbox_firewall_rule.tf", []string{
+			`resource "brightbox_firewall_rule" "fwr_aaaaa" {`,
+			"firewall_policy  = brightbox_firewall_policy.web.id",
+			"source           = brightbox_server_group.web_servers.id",
+		}},
+		{"brightbox_server.tf", []string{
+			`resource "brightbox_server" "web_1" {`,
+			`image            = "img-aaaaa"`,
+			"server_groups    = [brightbox_server_group.web_servers.id]",
+			`user_data_base64 = "IyEvYmluL3NoCmVjaG8gaGVsbG8K"`,
+			`id = "srv-aaaaa"`,
+		}},
+		{"brightbox_cloudip.tf", []string{
+			"target = brightbox_server.web_1.interface",
+		}},
+	}
+	for _, tcase := range testCases {
+		content, err := os.ReadFile(filepath.Join(dir, tcase.filename))
+		if err != nil {
+			t.Errorf("unexpected error: %s", err)
+			continue
+		}
+		for _, expected := range tcase.expected {
+			if !strings.Contains(string(content), expected) {
+				t.Errorf("%s: expected %q in:\n%s", tcase.filename, expected, content)
+			}
+		}
+		if tcase.filename == "brightbox_server.tf" && strings.Contains(string(content), "volume") {
+			t.Errorf("%s: expected no boot volume alongside the image:\n%s", tcase.filename, content)
+		}
+		if tcase.filename == "brightbox_server.tf" && generateUserDataRegexp.Match(content) {
+			t.Errorf("%s: expected no user data hash alongside user_data_base64:\n%s", tcase.filename, content)
+		}
+	}
+	if _, err := os.Stat(filepath.Join(dir, "brightbox_volume.tf")); !os.IsNotExist(err) {
+		t.Error("expected no file for a type without objects")
+	}
+}
+
+func TestGenerateConfigurationKeepsExistingFiles(t *testing.T) {
+	dir := t.TempDir()
+	existing := filepath.Join(dir, "brightbox_server_group.tf")
+	if err := os.WriteFile(existing, []byte("# mine\n"), 0o644); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	client := &CompositeClient{
+		APIClient: newFakeAPIClient(t, generateHandler(t)),
+		Account:   "acc-12345",
+	}
+	var out bytes.Buffer
+	if err := generateConfiguration(context.Background(), client, dir, &out); err == nil {
+		t.Fatal("expected an error")
+	}
+	content, _ := os.ReadFile(existing)
+	if string(content) != "# mine\n" {
+		t.Errorf("expected the existing file to be untouched, got %q", content)
+	}
+}
@@ -0,0 +1,156 @@
+package brightbox
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// resourceIdentity describes how a resource identifies its remote
+// object to Terraform, for import blocks and list queries
+type resourceIdentity struct {
+	schemaFunc func() map[string]*schema.Schema
+	// set records the identity of the object held in d
+	set func(d *schema.ResourceData, identity *schema.IdentityData, account string) error
+	// importID returns the import ID the resource importer expects for
+	// an identity given in an import block
+	importID func(identity *schema.IdentityData) (string, error)
+	// mutable is true if an update can change the identity
+	mutable bool
+}
+
+// objectIdentity identifies an object by its account and ID
+var objectIdentity = resourceIdentity{
+	schemaFunc: func() map[string]*schema.Schema {
+		return map[string]*schema.Schema{
+			accountAttribute: {
+				Description:       "The account the object belongs to. Defaults to the provider account",
+				Type:              schema.TypeString,
+				OptionalForImport: true,
+			},
+			"id": {
+				Description:       "The ID of the object",
+				Type:              schema.TypeString,
+				RequiredForImport: true,
+			},
+		}
+	},
+	set: func(d *schema.ResourceData, identity *schema.IdentityData, account string) error {
+		if err := identity.Set(accountAttribute, account); err != nil {
+			return err
+		}
+		return identity.Set("id", d.Id())
+	},
+	importID: func(identity *schema.IdentityData) (string, error) {
+		id, ok := identity.GetOk("id")
+		if !ok {
+			return "", fmt.Errorf("expected identity to contain id")
+		}
+		return id.(string), nil
+	},
+}
+
+// resourceIdentities lists the resources identified by more than their
+// account and ID
+var resourceIdentities = map[string]resourceIdentity{
+	"brightbox_server_group_membership": serverGroupMembershipIdentity,
+}
+
+// addResourceIdentity gives an importable resource an identity, records
+// it after every operation, and lets import blocks give it in place of
+// an import ID. It must be applied after addResourceAccountOverride.
+func addResourceIdentity(name string, resource *schema.Resource) {
+	if resource.Importer == nil {
+		return
+	}
+	identity, ok := resourceIdentities[name]
+	if !ok {
+		identity = objectIdentity
+	}
+	resource.Identity = &schema.ResourceIdentity{
+		SchemaFunc: identity.schemaFunc,
+	}
+	resource.ResourceBehavior.MutableIdentity = identity.mutable
+	resource.CreateContext = withIdentity(identity, resource.CreateContext)
+	resource.ReadContext = withIdentity(identity, resource.ReadContext)
+	resource.UpdateContext = withIdentity(identity, resource.UpdateContext)
+	resource.Importer = identityImporter(identity, resource.Importer)
+}
+
+// setIdentity records the identity of the object held in d
+func setIdentity(resourceIdentity resourceIdentity, d *schema.ResourceData, account string) error {
+	identity, err := d.Identity()
+	if err != nil {
+		return err
+	}
+	return resourceIdentity.set(d, identity, account)
+}
+
+func withIdentity[F ~func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics](
+	identity resourceIdentity,
+	operation F,
+) F {
+	if operation == nil {
+		return nil
+	}
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		diags := operation(ctx, d, meta)
+		if d.Id() == "" {
+			return diags
+		}
+		account, _ := d.Get(accountAttribute).(string)
+		if err := setIdentity(identity, d, account); err != nil {
+			diags = append(diags, diag.Errorf("unexpected: %s", err)...)
+		}
+		return diags
+	}
+}
+
+func identityImporter(identity resourceIdentity, importer *schema.ResourceImporter) *schema.ResourceImporter {
+	return &schema.ResourceImporter{
+		StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+			if d.Id() == "" {
+				given, err := d.Identity()
+				if err != nil {
+					return nil, err
+				}
+				importID, err := identity.importID(given)
+				if err != nil {
+					return nil, err
+				}
+				tflog.Debug(ctx, "Importing by identity", map[string]interface{}{
+					logFieldResourceID: importID,
+				})
+				d.SetId(importID)
+				if account, ok := given.GetOk(accountAttribute); ok {
+					if err := d.Set(accountAttribute, account); err != nil {
+						return nil, err
+					}
+				}
+			}
+			var results []*schema.ResourceData
+			var err error
+			if importer.StateContext != nil {
+				results, err = importer.StateContext(ctx, d, meta)
+			} else {
+				results, err = importer.State(d, meta)
+			}
+			if err != nil {
+				return nil, err
+			}
+			for _, result := range results {
+				client, diags := accountClient(ctx, result, meta)
+				if diags.HasError() {
+					return nil, diagnosticsError(diags)
+				}
+				if err := setIdentity(identity, result, client.Account); err != nil {
+					return nil, err
+				}
+			}
+			return results, nil
+		},
+	}
+}
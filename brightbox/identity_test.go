@@ -0,0 +1,139 @@
+package brightbox
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestImportableResourcesHaveIdentity(t *testing.T) {
+	for name, resource := range Provider().ResourcesMap {
+		if resource.Importer == nil {
+			continue
+		}
+		if resource.Identity == nil {
+			t.Errorf("%s is importable but has no identity", name)
+			continue
+		}
+		if err := resource.Identity.InternalIdentityValidate(); err != nil {
+			t.Errorf("%s: invalid identity: %s", name, err)
+		}
+	}
+}
+
+func importWithIdentity(
+	t *testing.T,
+	name string,
+	id string,
+	identity map[string]string,
+) *schema.ResourceData {
+	t.Helper()
+	resource := Provider().ResourcesMap[name]
+	d := schema.TestResourceDataWithIdentityRaw(t, resource.Schema, resource.Identity.SchemaFunc(), identity)
+	d.SetId(id)
+	results, err := resource.Importer.StateContext(
+		context.Background(),
+		d,
+		&CompositeClient{Account: "acc-12345"},
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(results) != 1 {
+		t.Fatalf("expected a single result, got %d", len(results))
+	}
+	return results[0]
+}
+
+func identityOf(t *testing.T, d *schema.ResourceData) map[string]interface{} {
+	t.Helper()
+	identity, err := d.Identity()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	result := make(map[string]interface{})
+	for _, key := range []string{accountAttribute, "id", "group", "servers"} {
+		if value, ok := identity.GetOk(key); ok {
+			result[key] = value
+		}
+	}
+	return result
+}
+
+func TestImportByObjectIdentity(t *testing.T) {
+	testCases := []struct {
+		name     string
+		id       string
+		identity map[string]string
+	}{
+		{"by identity", "", map[string]string{"id": "grp-abcde"}},
+		{"by identity with account", "", map[string]string{"id": "grp-abcde", "account": "acc-12345"}},
+		{"by ID", "grp-abcde", nil},
+		{"by ID with account", "acc-12345/grp-abcde", nil},
+	}
+	for _, tcase := range testCases {
+		t.Run(tcase.name, func(t *testing.T) {
+			result := importWithIdentity(t, "brightbox_server_group", tcase.id, tcase.identity)
+			if result.Id() != "grp-abcde" {
+				t.Errorf("expected ID grp-abcde, got %q", result.Id())
+			}
+			identity := identityOf(t, result)
+			if identity["id"] != "grp-abcde" || identity[accountAttribute] != "acc-12345" {
+				t.Errorf("unexpected identity: %v", identity)
+			}
+		})
+	}
+}
+
+func TestImportServerGroupMembershipByIdentity(t *testing.T) {
+	result := importWithIdentity(t, "brightbox_server_group_membership", "", map[string]string{
+		"group":     "grp-abcde",
+		"servers.#": "2",
+		"servers.0": "srv-bbbbb",
+		"servers.1": "srv-aaaaa",
+	})
+	if group := result.Get("group"); group != "grp-abcde" {
+		t.Errorf("expected group grp-abcde, got %v", group)
+	}
+	if servers := result.Get("servers").(*schema.Set).Len(); servers != 2 {
+		t.Errorf("expected 2 servers, got %d", servers)
+	}
+	identity := identityOf(t, result)
+	if fmt.Sprint(identity["servers"]) != "[srv-aaaaa srv-bbbbb]" {
+		t.Errorf("expected sorted servers in identity, got %v", identity["servers"])
+	}
+	if identity[accountAttribute] != "acc-12345" {
+		t.Errorf("expected the provider account in identity, got %v", identity[accountAttribute])
+	}
+}
+
+func TestImportServerGroupMembershipByIncompleteIdentity(t *testing.T) {
+	resource := Provider().ResourcesMap["brightbox_server_group_membership"]
+	d := schema.TestResourceDataWithIdentityRaw(t, resource.Schema, resource.Identity.SchemaFunc(), map[string]string{
+		"group": "grp-abcde",
+	})
+	_, err := resource.Importer.StateContext(context.Background(), d, &CompositeClient{Account: "acc-12345"})
+	if err == nil {
+		t.Fatal("expected an error for an identity without servers")
+	}
+}
+
+func TestWithIdentityRecordsObject(t *testing.T) {
+	resource := Provider().ResourcesMap["brightbox_server_group"]
+	d := schema.TestResourceDataWithIdentityRaw(t, resource.Schema, resource.Identity.SchemaFunc(), nil)
+	create := withIdentity(objectIdentity, func(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+		d.SetId("grp-abcde")
+		d.Set(accountAttribute, "acc-12345")
+		return nil
+	})
+	if diags := create(context.Background(), d, nil); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	identity := identityOf(t, d)
+	if identity["id"] != "grp-abcde" || identity[accountAttribute] != "acc-12345" {
+		t.Errorf("unexpected identity: %v", identity)
+	}
+}
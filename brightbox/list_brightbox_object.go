@@ -0,0 +1,384 @@
+package brightbox
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+
+	brightbox "github.com/brightbox/gobrightbox/v2"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	fwdiag "github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var (
+	_ list.ListResource                 = (*objectListResource[brightbox.Server])(nil)
+	_ list.ListResourceWithConfigure    = (*objectListResource[brightbox.Server])(nil)
+	_ list.ListResourceWithRawV5Schemas = (*objectListResource[brightbox.Server])(nil)
+)
+
+// objectListResource is a plugin framework list resource that finds the
+// remote objects of an SDKv2 managed resource, so that `terraform query`
+// can generate import blocks for those not yet managed
+type objectListResource[O any] struct {
+	frameworkClient
+	typeName   string
+	objectName string
+	resource   *schema.Resource
+	lister     func(ctx context.Context, client *CompositeClient) ([]O, error)
+	id         func(O) string
+	name       func(O) string
+	// nameField is what name_regex matches, if not the name
+	nameField string
+	// importID returns the ID the resource importer expects for an
+	// object, if it is not the object ID
+	importID func(O) string
+	// The optional filters, offered only if the object supports them
+	zone         func(O) *brightbox.Zone
+	serverGroups func(O) []string
+	status       func(O) string
+	statuses     []string
+}
+
+// listResourceTypes holds the constructors of the list resources, by the
+// type name of the managed resource they list
+var listResourceTypes = map[string]func(*schema.Resource) list.ListResource{
+	"brightbox_api_client":              newAPIClientListResource,
+	"brightbox_cloudip":                 newCloudIPListResource,
+	"brightbox_config_map":              newConfigMapListResource,
+	"brightbox_database_server":         newDatabaseServerListResource,
+	"brightbox_firewall_policy":         newFirewallPolicyListResource,
+	"brightbox_firewall_rule":           newFirewallRuleListResource,
+	"brightbox_load_balancer":           newLoadBalancerListResource,
+	"brightbox_orbit_container":         newContainerListResource,
+	"brightbox_server":                  newServerListResource,
+	"brightbox_server_group":            newServerGroupListResource,
+	"brightbox_server_group_membership": newServerGroupMembershipListResource,
+	"brightbox_volume":                  newVolumeListResource,
+}
+
+// listResources returns a list resource for each of the managed
+// resources that has one
+func listResources(resources map[string]*schema.Resource) []func() list.ListResource {
+	var result []func() list.ListResource
+	for name, resource := range resources {
+		constructor, ok := listResourceTypes[name]
+		if !ok {
+			continue
+		}
+		result = append(result, func() list.ListResource {
+			return constructor(resource)
+		})
+	}
+	return result
+}
+
+// apiLister adapts a Brightbox API list call to an object lister
+func apiLister[O any](
+	reader func(*brightbox.Client, context.Context) ([]O, error),
+) func(context.Context, *CompositeClient) ([]O, error) {
+	return func(ctx context.Context, client *CompositeClient) ([]O, error) {
+		return reader(client.APIClient, ctx)
+	}
+}
+
+func (l *objectListResource[O]) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_" + l.typeName
+}
+
+func (l *objectListResource[O]) ListResourceConfigSchema(
+	_ context.Context,
+	_ list.ListResourceSchemaRequest,
+	resp *list.ListResourceSchemaResponse,
+) {
+	nameField := l.nameField
+	if nameField == "" {
+		nameField = "name"
+	}
+	attributes := map[string]listschema.Attribute{
+		accountAttribute: listschema.StringAttribute{
+			Description: "The account to search, if different from the provider account",
+			Optional:    true,
+			Validators: []validator.String{
+				stringvalidator.RegexMatches(accountRegexp, "must be a valid account ID"),
+			},
+		},
+		"name_regex": listschema.StringAttribute{
+			Description: fmt.Sprintf("A regex to match against the %s %ss", l.objectName, nameField),
+			Optional:    true,
+		},
+	}
+	if l.zone != nil {
+		attributes["zone"] = listschema.StringAttribute{
+			Description: fmt.Sprintf("Only list %ss in this zone, given by ID or handle", l.objectName),
+			Optional:    true,
+			Validators: []validator.String{
+				stringvalidator.RegexMatches(zoneRegexp, "must be a valid zone ID or handle"),
+			},
+		}
+	}
+	if l.serverGroups != nil {
+		attributes["server_group"] = listschema.StringAttribute{
+			Description: fmt.Sprintf("Only list %ss belonging to this server group", l.objectName),
+			Optional:    true,
+			Validators: []validator.String{
+				stringvalidator.RegexMatches(serverGroupRegexp, "must be a valid server group ID"),
+			},
+		}
+	}
+	if l.status != nil {
+		attributes["status"] = listschema.StringAttribute{
+			Description: fmt.Sprintf("Only list %ss in this state", l.objectName),
+			Optional:    true,
+			Validators: []validator.String{
+				stringvalidator.OneOf(l.statuses...),
+			},
+		}
+	}
+	resp.Schema = listschema.Schema{
+		Description: fmt.Sprintf("Lists the Brightbox %ss that match the filters", l.objectName),
+		Attributes:  attributes,
+	}
+}
+
+func (l *objectListResource[O]) RawV5Schemas(
+	ctx context.Context,
+	_ list.RawV5SchemaRequest,
+	resp *list.RawV5SchemaResponse,
+) {
+	resp.ProtoV5Schema = l.resource.ProtoSchema(ctx)()
+	resp.ProtoV5IdentitySchema = l.resource.ProtoIdentitySchema(ctx)()
+}
+
+func (l *objectListResource[O]) Configure(
+	_ context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	l.configure(req.ProviderData, &resp.Diagnostics)
+}
+
+func (l *objectListResource[O]) List(
+	ctx context.Context,
+	req list.ListRequest,
+	stream *list.ListResultsStream,
+) {
+	var diags fwdiag.Diagnostics
+	filters := make(map[string]string)
+	var account types.String
+	diags.Append(req.Config.GetAttribute(ctx, path.Root(accountAttribute), &account)...)
+	for _, key := range l.filterAttributes() {
+		var value types.String
+		diags.Append(req.Config.GetAttribute(ctx, path.Root(key), &value)...)
+		filters[key] = value.ValueString()
+	}
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+	ctx = contextWithFrameworkLogging(ctx, "brightbox_"+l.typeName, "")
+	ctx = tflog.SetField(ctx, logFieldObjectName, l.objectName)
+	client, errs := l.forAccount(ctx, account)
+	diags.Append(errs...)
+	findFunc, errs := l.finder(filters)
+	diags.Append(errs...)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	tflog.Debug(ctx, "List called. Retrieving object list")
+	objects, err := l.lister(ctx, client)
+	if err != nil {
+		stream.Results = list.ListResultsStreamDiagnostics(frameworkDiagnostics(brightboxFromErrSlice(err)))
+		return
+	}
+	results := filter(objects, findFunc)
+	tflog.Debug(ctx, "Objects found", map[string]interface{}{
+		"count": len(results),
+	})
+	stream.Results = func(push func(list.ListResult) bool) {
+		var count int64
+		for _, object := range results {
+			if req.Limit > 0 && count >= req.Limit {
+				return
+			}
+			result, ok := l.result(ctx, req, client, object)
+			if !ok {
+				continue
+			}
+			count++
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
+
+// filterAttributes returns the names of the filters the list resource
+// offers
+func (l *objectListResource[O]) filterAttributes() []string {
+	result := []string{"name_regex"}
+	if l.zone != nil {
+		result = append(result, "zone")
+	}
+	if l.serverGroups != nil {
+		result = append(result, "server_group")
+	}
+	if l.status != nil {
+		result = append(result, "status")
+	}
+	return result
+}
+
+// finder returns a function matching the objects that pass the filters
+func (l *objectListResource[O]) finder(filters map[string]string) (func(O) bool, fwdiag.Diagnostics) {
+	var diags fwdiag.Diagnostics
+	var nameRe *regexp.Regexp
+	if filters["name_regex"] != "" {
+		var err error
+		if nameRe, err = regexp.Compile(filters["name_regex"]); err != nil {
+			diags.AddAttributeError(path.Root("name_regex"), "Invalid regex", err.Error())
+		}
+	}
+	zone := filters["zone"]
+	serverGroup := filters["server_group"]
+	status := filters["status"]
+
+	return func(object O) bool {
+		if nameRe != nil && !nameRe.MatchString(l.name(object)) {
+			return false
+		}
+		if zone != "" {
+			objectZone := l.zone(object)
+			if objectZone == nil || (objectZone.ID != zone && objectZone.Handle != zone) {
+				return false
+			}
+		}
+		if serverGroup != "" && !slices.Contains(l.serverGroups(object), serverGroup) {
+			return false
+		}
+		if status != "" && l.status(object) != status {
+			return false
+		}
+		// Deleted objects linger in the API for a while, but cannot be
+		// imported
+		if status == "" && l.status != nil && l.status(object) == "deleted" {
+			return false
+		}
+		return true
+	}, diags
+}
+
+// result imports object the way an import block would, returning its
+// identity and, if requested, its full state. It returns false if the
+// object disappeared after it was listed.
+func (l *objectListResource[O]) result(
+	ctx context.Context,
+	req list.ListRequest,
+	client *CompositeClient,
+	object O,
+) (list.ListResult, bool) {
+	result := req.NewListResult(ctx)
+	id := l.id(object)
+	result.DisplayName = id
+	if name := l.name(object); name != "" {
+		result.DisplayName = fmt.Sprintf("%s (%s)", name, id)
+	}
+	d, diags := importObject(ctx, l.resource, client, l.objectImportID(object), req.IncludeResource)
+	result.Diagnostics.Append(frameworkDiagnostics(diags)...)
+	if result.Diagnostics.HasError() {
+		return result, true
+	}
+	if d == nil {
+		return result, false
+	}
+	if req.IncludeResource {
+		state, err := d.TfTypeResourceState()
+		if err != nil {
+			result.Diagnostics.AddError("unexpected", err.Error())
+			return result, true
+		}
+		result.Resource.Raw = *state
+	}
+	identity, err := d.TfTypeIdentityState()
+	if err != nil {
+		result.Diagnostics.AddError("unexpected", err.Error())
+		return result, true
+	}
+	result.Identity.Raw = *identity
+	return result, true
+}
+
+// objectImportID returns the ID the resource importer expects for object
+func (l *objectListResource[O]) objectImportID(object O) string {
+	if l.importID != nil {
+		return l.importID(object)
+	}
+	return l.id(object)
+}
+
+// objectImporter finds and imports the objects of a managed resource,
+// whatever their type
+type objectImporter interface {
+	managedResource() *schema.Resource
+	// importIDs returns the import IDs of every object that can be
+	// imported
+	importIDs(ctx context.Context, client *CompositeClient) ([]string, error)
+}
+
+func (l *objectListResource[O]) managedResource() *schema.Resource {
+	return l.resource
+}
+
+func (l *objectListResource[O]) importIDs(ctx context.Context, client *CompositeClient) ([]string, error) {
+	objects, err := l.lister(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	findFunc, _ := l.finder(nil)
+	return idList(filter(objects, findFunc), l.objectImportID), nil
+}
+
+// importObject imports the object with importID the way an import block
+// would, reading its full state if read is true. It returns nil if the
+// object has disappeared.
+func importObject(
+	ctx context.Context,
+	resource *schema.Resource,
+	client *CompositeClient,
+	importID string,
+	read bool,
+) (*schema.ResourceData, diag.Diagnostics) {
+	d := resource.Data(nil)
+	d.SetId(importID)
+	if err := d.Set(accountAttribute, client.Account); err != nil {
+		return nil, diag.Errorf("unexpected: %s", err)
+	}
+	imported, err := resource.Importer.StateContext(ctx, d, client)
+	if err != nil {
+		return nil, brightboxFromErrSlice(err)
+	}
+	d = imported[0]
+	if !read {
+		return d, nil
+	}
+	diags := resource.ReadContext(ctx, d, client)
+	if diags.HasError() || d.Id() == "" {
+		return nil, diags
+	}
+	return d, diags
+}
@@ -0,0 +1,308 @@
+package brightbox
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestListResourcesCoverImportableResources(t *testing.T) {
+	resources := Provider().ResourcesMap
+	for name, resource := range resources {
+		if _, ok := listResourceTypes[name]; resource.Importer != nil && !ok {
+			t.Errorf("%s is importable but has no list resource", name)
+		}
+	}
+	for name := range listResourceTypes {
+		if resources[name] == nil || resources[name].Importer == nil {
+			t.Errorf("list resource %s has no importable managed resource", name)
+		}
+	}
+}
+
+// listObjects configures the muxed provider with a client for the fake
+// API and runs the typeName list resource with the given configuration
+// values. Attributes missing from config are null.
+func listObjects(
+	t *testing.T,
+	typeName string,
+	handler http.HandlerFunc,
+	config map[string]tftypes.Value,
+	includeResource bool,
+	limit int64,
+) []tfprotov5.ListResourceResult {
+	t.Helper()
+	ctx := context.Background()
+	authd := authdetails{
+		APIClient: "cli-12345",
+		APISecret: "secret",
+		Account:   "acc-12345",
+		APIURL:    "https://api.test/",
+		OrbitURL:  "https://orbit.test/",
+	}
+	sharedClientsMutex.Lock()
+	sharedClients[authd] = &CompositeClient{
+		APIClient: newFakeAPIClient(t, handler),
+		Account:   authd.Account,
+		authd:     authd,
+	}
+	sharedClientsMutex.Unlock()
+	t.Cleanup(func() {
+		sharedClientsMutex.Lock()
+		delete(sharedClients, authd)
+		sharedClientsMutex.Unlock()
+	})
+
+	server, err := testAccProtoV5ProviderFactories()["brightbox"]()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	schemaResp, err := server.GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	providerConfig := dynamicValue(t, schemaResp.Provider.ValueType(), map[string]tftypes.Value{
+		"account":   tftypes.NewValue(tftypes.String, authd.Account),
+		"apiclient": tftypes.NewValue(tftypes.String, authd.APIClient),
+		"apisecret": tftypes.NewValue(tftypes.String, authd.APISecret),
+		"apiurl":    tftypes.NewValue(tftypes.String, authd.APIURL),
+		"orbit_url": tftypes.NewValue(tftypes.String, authd.OrbitURL),
+		"password":  tftypes.NewValue(tftypes.String, ""),
+		"username":  tftypes.NewValue(tftypes.String, ""),
+	})
+	configureResp, err := server.ConfigureProvider(ctx, &tfprotov5.ConfigureProviderRequest{
+		Config: providerConfig,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	for _, d := range configureResp.Diagnostics {
+		t.Fatalf("unexpected diagnostic: %s: %s", d.Summary, d.Detail)
+	}
+	listSchema, ok := schemaResp.ListResourceSchemas[typeName]
+	if !ok {
+		t.Fatalf("no list resource schema for %s", typeName)
+	}
+	stream, err := server.(tfprotov5.ProviderServerWithListResource).ListResource(ctx, &tfprotov5.ListResourceRequest{
+		TypeName:        typeName,
+		Config:          dynamicValue(t, listSchema.ValueType(), config),
+		IncludeResource: includeResource,
+		Limit:           limit,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	var results []tfprotov5.ListResourceResult
+	for result := range stream.Results {
+		results = append(results, result)
+	}
+	return results
+}
+
+// dynamicValue builds an object of objectType from values, leaving the
+// attributes missing from values null
+func dynamicValue(t *testing.T, objectType tftypes.Type, values map[string]tftypes.Value) *tfprotov5.DynamicValue {
+	t.Helper()
+	attributes := make(map[string]tftypes.Value)
+	for key, attributeType := range objectType.(tftypes.Object).AttributeTypes {
+		if value, ok := values[key]; ok {
+			attributes[key] = value
+		} else {
+			attributes[key] = tftypes.NewValue(attributeType, nil)
+		}
+	}
+	result, err := tfprotov5.NewDynamicValue(objectType, tftypes.NewValue(objectType, attributes))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	return &result
+}
+
+// resultValues decodes the attributes of a listed identity or resource
+// into strings, skipping those that are not strings
+func resultValues(t *testing.T, value *tfprotov5.DynamicValue, valueType tftypes.Type) map[string]string {
+	t.Helper()
+	decoded, err := value.Unmarshal(valueType)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	var attributes map[string]tftypes.Value
+	if err := decoded.As(&attributes); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	result := make(map[string]string)
+	for key, attribute := range attributes {
+		var text string
+		if attribute.Type().Is(tftypes.String) && attribute.As(&text) == nil {
+			result[key] = text
+		}
+	}
+	return result
+}
+
+func checkListDiagnostics(t *testing.T, results []tfprotov5.ListResourceResult) {
+	t.Helper()
+	for _, result := range results {
+		for _, d := range result.Diagnostics {
+			if d.Severity == tfprotov5.DiagnosticSeverityError {
+				t.Fatalf("unexpected error: %s: %s", d.Summary, d.Detail)
+			}
+		}
+	}
+}
+
+const listServersJSON = `[
+	{"id":"srv-aaaaa","name":"web-1","status":"active","zone":{"id":"zon-aaaaa","handle":"gb1-a"},"server_groups":[{"id":"grp-aaaaa"}]},
+	{"id":"srv-bbbbb","name":"web-2","status":"inactive","zone":{"id":"zon-bbbbb","handle":"gb1-b"},"server_groups":[{"id":"grp-aaaaa"}]},
+	{"id":"srv-ccccc","name":"db-1","status":"active","zone":{"id":"zon-aaaaa","handle":"gb1-a"},"server_groups":[]},
+	{"id":"srv-ddddd","name":"web-3","status":"deleted","zone":{"id":"zon-aaaaa","handle":"gb1-a"},"server_groups":[]}
+]`
+
+func listServersHandler(t *testing.T) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/1.0/servers":
+			w.Write([]byte(listServersJSON))
+		case r.Method == http.MethodGet && r.URL.Path == "/1.0/servers/srv-aaaaa":
+			w.Write([]byte(`{"id":"srv-aaaaa","name":"web-1","status":"active","hostname":"srv-aaaaa",
+				"image":{"id":"img-aaaaa","username":"ubuntu"},
+				"server_type":{"id":"typ-aaaaa","handle":"1gb.ssd","disk_size":30720},
+				"zone":{"id":"zon-aaaaa","handle":"gb1-a"},"server_groups":[{"id":"grp-aaaaa"}]}`))
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			http.NotFound(w, r)
+		}
+	}
+}
+
+func TestServerListResource(t *testing.T) {
+	identityType := Provider().ResourcesMap["brightbox_server"].ProtoIdentitySchema(context.Background())().ValueType()
+	testCases := []struct {
+		name     string
+		config   map[string]tftypes.Value
+		limit    int64
+		expected []string
+	}{
+		{"all", nil, 0, []string{"srv-aaaaa", "srv-bbbbb", "srv-ccccc"}},
+		{"name_regex", map[string]tftypes.Value{
+			"name_regex": tftypes.NewValue(tftypes.String, "^web-"),
+		}, 0, []string{"srv-aaaaa", "srv-bbbbb"}},
+		{"zone", map[string]tftypes.Value{
+			"zone": tftypes.NewValue(tftypes.String, "gb1-a"),
+		}, 0, []string{"srv-aaaaa", "srv-ccccc"}},
+		{"server_group", map[string]tftypes.Value{
+			"server_group": tftypes.NewValue(tftypes.String, "grp-aaaaa"),
+		}, 0, []string{"srv-aaaaa", "srv-bbbbb"}},
+		{"status", map[string]tftypes.Value{
+			"status": tftypes.NewValue(tftypes.String, "deleted"),
+		}, 0, []string{"srv-ddddd"}},
+		{"limit", nil, 2, []string{"srv-aaaaa", "srv-bbbbb"}},
+	}
+	for _, tcase := range testCases {
+		t.Run(tcase.name, func(t *testing.T) {
+			results := listObjects(t, "brightbox_server", listServersHandler(t), tcase.config, false, tcase.limit)
+			checkListDiagnostics(t, results)
+			var ids []string
+			for _, result := range results {
+				if result.Resource != nil {
+					t.Errorf("%s: expected no resource state", result.DisplayName)
+				}
+				identity := resultValues(t, result.Identity.IdentityData, identityType)
+				if identity[accountAttribute] != "acc-12345" {
+					t.Errorf("%s: expected account acc-12345, got %q", result.DisplayName, identity[accountAttribute])
+				}
+				ids = append(ids, identity["id"])
+			}
+			if fmt.Sprint(ids) != fmt.Sprint(tcase.expected) {
+				t.Errorf("expected %v, got %v", tcase.expected, ids)
+			}
+		})
+	}
+}
+
+func TestServerListResourceIncludeResource(t *testing.T) {
+	resourceType := Provider().ResourcesMap["brightbox_server"].ProtoSchema(context.Background())().ValueType()
+	results := listObjects(t, "brightbox_server", listServersHandler(t), map[string]tftypes.Value{
+		"name_regex": tftypes.NewValue(tftypes.String, "^web-1$"),
+	}, true, 0)
+	checkListDiagnostics(t, results)
+	if len(results) != 1 {
+		t.Fatalf("expected a single result, got %d", len(results))
+	}
+	if results[0].DisplayName != "web-1 (srv-aaaaa)" {
+		t.Errorf("unexpected display name %q", results[0].DisplayName)
+	}
+	state := resultValues(t, results[0].Resource, resourceType)
+	expected := map[string]string{
+		"id":             "srv-aaaaa",
+		"name":           "web-1",
+		"image":          "img-aaaaa",
+		"zone":           "gb1-a",
+		"username":       "ubuntu",
+		"status":         "active",
+		accountAttribute: "acc-12345",
+	}
+	for key := range expected {
+		if state[key] != expected[key] {
+			t.Errorf("%s: expected %q, got %q", key, expected[key], state[key])
+		}
+	}
+}
+
+func TestServerListResourceInvalidRegex(t *testing.T) {
+	results := listObjects(t, "brightbox_server", listServersHandler(t), map[string]tftypes.Value{
+		"name_regex": tftypes.NewValue(tftypes.String, "("),
+	}, false, 0)
+	if len(results) != 1 || len(results[0].Diagnostics) == 0 {
+		t.Fatalf("expected a single error result, got %v", results)
+	}
+}
+
+func TestServerGroupMembershipListResource(t *testing.T) {
+	identityType := Provider().ResourcesMap["brightbox_server_group_membership"].ProtoIdentitySchema(context.Background())().ValueType()
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method != http.MethodGet || r.URL.Path != "/1.0/server_groups" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			http.NotFound(w, r)
+			return
+		}
+		w.Write([]byte(`[
+			{"id":"grp-aaaaa","name":"web","servers":[{"id":"srv-bbbbb"},{"id":"srv-aaaaa"}]},
+			{"id":"grp-bbbbb","name":"empty","servers":[]}
+		]`))
+	}
+	results := listObjects(t, "brightbox_server_group_membership", handler, nil, false, 0)
+	checkListDiagnostics(t, results)
+	if len(results) != 1 {
+		t.Fatalf("expected a single membership, got %d", len(results))
+	}
+	decoded, err := results[0].Identity.IdentityData.Unmarshal(identityType)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	var identity map[string]tftypes.Value
+	if err := decoded.As(&identity); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	var group string
+	var servers []tftypes.Value
+	identity["group"].As(&group)
+	identity["servers"].As(&servers)
+	ids := make([]string, len(servers))
+	for i, server := range servers {
+		server.As(&ids[i])
+	}
+	if group != "grp-aaaaa" {
+		t.Errorf("expected group grp-aaaaa, got %q", group)
+	}
+	if !sort.StringsAreSorted(ids) || fmt.Sprint(ids) != "[srv-aaaaa srv-bbbbb]" {
+		t.Errorf("expected sorted servers, got %v", ids)
+	}
+}
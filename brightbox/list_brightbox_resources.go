@@ -0,0 +1,224 @@
+package brightbox
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+
+	brightbox "github.com/brightbox/gobrightbox/v2"
+	"github.com/brightbox/gobrightbox/v2/enums/cloudipstatus"
+	"github.com/brightbox/gobrightbox/v2/enums/databaseserverstatus"
+	"github.com/brightbox/gobrightbox/v2/enums/loadbalancerstatus"
+	"github.com/brightbox/gobrightbox/v2/enums/serverstatus"
+	"github.com/brightbox/gobrightbox/v2/enums/volumestatus"
+	"github.com/gophercloud/gophercloud/openstack/objectstorage/v1/containers"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func newAPIClientListResource(resource *schema.Resource) list.ListResource {
+	return &objectListResource[brightbox.APIClient]{
+		typeName:   "api_client",
+		objectName: "API Client",
+		resource:   resource,
+		lister:     apiLister(activeAPIClients),
+		id:         func(v brightbox.APIClient) string { return v.ID },
+		name:       func(v brightbox.APIClient) string { return v.Name },
+	}
+}
+
+// activeAPIClients lists the API clients that have not been revoked
+func activeAPIClients(client *brightbox.Client, ctx context.Context) ([]brightbox.APIClient, error) {
+	apiClients, err := client.APIClients(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return filter(apiClients, func(v brightbox.APIClient) bool { return v.RevokedAt == nil }), nil
+}
+
+func newCloudIPListResource(resource *schema.Resource) list.ListResource {
+	return &objectListResource[brightbox.CloudIP]{
+		typeName:   "cloudip",
+		objectName: "Cloud IP",
+		resource:   resource,
+		lister:     apiLister((*brightbox.Client).CloudIPs),
+		id:         func(v brightbox.CloudIP) string { return v.ID },
+		name:       func(v brightbox.CloudIP) string { return v.Name },
+		serverGroups: func(v brightbox.CloudIP) []string {
+			if v.ServerGroup == nil {
+				return nil
+			}
+			return []string{v.ServerGroup.ID}
+		},
+		status:   func(v brightbox.CloudIP) string { return v.Status.String() },
+		statuses: cloudipstatus.ValidStrings,
+	}
+}
+
+func newConfigMapListResource(resource *schema.Resource) list.ListResource {
+	return &objectListResource[brightbox.ConfigMap]{
+		typeName:   "config_map",
+		objectName: "Config Map",
+		resource:   resource,
+		lister:     apiLister((*brightbox.Client).ConfigMaps),
+		id:         func(v brightbox.ConfigMap) string { return v.ID },
+		name:       func(v brightbox.ConfigMap) string { return v.Name },
+	}
+}
+
+func newDatabaseServerListResource(resource *schema.Resource) list.ListResource {
+	return &objectListResource[brightbox.DatabaseServer]{
+		typeName:   "database_server",
+		objectName: "Database Server",
+		resource:   resource,
+		lister:     apiLister((*brightbox.Client).DatabaseServers),
+		id:         func(v brightbox.DatabaseServer) string { return v.ID },
+		name:       func(v brightbox.DatabaseServer) string { return v.Name },
+		zone:       func(v brightbox.DatabaseServer) *brightbox.Zone { return v.Zone },
+		status:     func(v brightbox.DatabaseServer) string { return v.Status.String() },
+		statuses:   databaseserverstatus.ValidStrings,
+	}
+}
+
+func newFirewallPolicyListResource(resource *schema.Resource) list.ListResource {
+	return &objectListResource[brightbox.FirewallPolicy]{
+		typeName:   "firewall_policy",
+		objectName: "Firewall Policy",
+		resource:   resource,
+		lister:     apiLister((*brightbox.Client).FirewallPolicies),
+		id:         func(v brightbox.FirewallPolicy) string { return v.ID },
+		name:       func(v brightbox.FirewallPolicy) string { return v.Name },
+		serverGroups: func(v brightbox.FirewallPolicy) []string {
+			if v.ServerGroup == nil {
+				return nil
+			}
+			return []string{v.ServerGroup.ID}
+		},
+	}
+}
+
+// Firewall rules have no name, so they are matched by description
+func newFirewallRuleListResource(resource *schema.Resource) list.ListResource {
+	return &objectListResource[brightbox.FirewallRule]{
+		typeName:   "firewall_rule",
+		objectName: "Firewall Rule",
+		resource:   resource,
+		lister:     apiLister((*brightbox.Client).FirewallRules),
+		id:         func(v brightbox.FirewallRule) string { return v.ID },
+		name:       func(v brightbox.FirewallRule) string { return v.Description },
+		nameField:  "description",
+	}
+}
+
+func newLoadBalancerListResource(resource *schema.Resource) list.ListResource {
+	return &objectListResource[brightbox.LoadBalancer]{
+		typeName:   "load_balancer",
+		objectName: "Load Balancer",
+		resource:   resource,
+		lister:     apiLister((*brightbox.Client).LoadBalancers),
+		id:         func(v brightbox.LoadBalancer) string { return v.ID },
+		name:       func(v brightbox.LoadBalancer) string { return v.Name },
+		status:     func(v brightbox.LoadBalancer) string { return v.Status.String() },
+		statuses:   loadbalancerstatus.ValidStrings,
+	}
+}
+
+// Orbit containers are identified by their names
+func newContainerListResource(resource *schema.Resource) list.ListResource {
+	return &objectListResource[string]{
+		typeName:   "orbit_container",
+		objectName: "Orbit Container",
+		resource:   resource,
+		lister:     listContainers,
+		id:         func(v string) string { return v },
+		name:       func(string) string { return "" },
+	}
+}
+
+// errOrbitUnavailable is returned when the credentials could not obtain
+// an Orbit client
+var errOrbitUnavailable = errors.New("Orbit is not available with these credentials")
+
+// listContainers lists the names of the Orbit containers
+func listContainers(ctx context.Context, client *CompositeClient) ([]string, error) {
+	orbitClient := client.OrbitClient
+	if orbitClient == nil {
+		return nil, errOrbitUnavailable
+	}
+	orbitClient.ProviderClient.Context = ctx
+	pages, err := containers.List(orbitClient, nil).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	return containers.ExtractNames(pages)
+}
+
+func newServerListResource(resource *schema.Resource) list.ListResource {
+	return &objectListResource[brightbox.Server]{
+		typeName:   "server",
+		objectName: "Server",
+		resource:   resource,
+		lister:     apiLister((*brightbox.Client).Servers),
+		id:         func(v brightbox.Server) string { return v.ID },
+		name:       func(v brightbox.Server) string { return v.Name },
+		zone:       func(v brightbox.Server) *brightbox.Zone { return v.Zone },
+		serverGroups: func(v brightbox.Server) []string {
+			return idList(v.ServerGroups, func(g brightbox.ServerGroup) string { return g.ID })
+		},
+		status:   func(v brightbox.Server) string { return v.Status.String() },
+		statuses: serverstatus.ValidStrings,
+	}
+}
+
+func newServerGroupListResource(resource *schema.Resource) list.ListResource {
+	return &objectListResource[brightbox.ServerGroup]{
+		typeName:   "server_group",
+		objectName: "Server Group",
+		resource:   resource,
+		lister:     apiLister((*brightbox.Client).ServerGroups),
+		id:         func(v brightbox.ServerGroup) string { return v.ID },
+		name:       func(v brightbox.ServerGroup) string { return v.Name },
+	}
+}
+
+// A membership is listed for each server group with servers in it
+func newServerGroupMembershipListResource(resource *schema.Resource) list.ListResource {
+	return &objectListResource[brightbox.ServerGroup]{
+		typeName:   "server_group_membership",
+		objectName: "Server Group Membership",
+		resource:   resource,
+		lister:     apiLister(occupiedServerGroups),
+		id:         func(v brightbox.ServerGroup) string { return v.ID },
+		name:       func(v brightbox.ServerGroup) string { return v.Name },
+		nameField:  "server group name",
+		importID: func(v brightbox.ServerGroup) string {
+			servers := idList(v.Servers, func(s brightbox.Server) string { return s.ID })
+			sort.Strings(servers)
+			return strings.Join(append([]string{v.ID}, servers...), "/")
+		},
+		serverGroups: func(v brightbox.ServerGroup) []string { return []string{v.ID} },
+	}
+}
+
+// occupiedServerGroups lists the server groups that have servers in them
+func occupiedServerGroups(client *brightbox.Client, ctx context.Context) ([]brightbox.ServerGroup, error) {
+	serverGroups, err := client.ServerGroups(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return filter(serverGroups, func(v brightbox.ServerGroup) bool { return len(v.Servers) > 0 }), nil
+}
+
+func newVolumeListResource(resource *schema.Resource) list.ListResource {
+	return &objectListResource[brightbox.Volume]{
+		typeName:   "volume",
+		objectName: "Volume",
+		resource:   resource,
+		lister:     apiLister((*brightbox.Client).Volumes),
+		id:         func(v brightbox.Volume) string { return v.ID },
+		name:       func(v brightbox.Volume) string { return v.Name },
+		status:     func(v brightbox.Volume) string { return v.Status.String() },
+		statuses:   volumestatus.ValidStrings,
+	}
+}
@@ -0,0 +1,194 @@
+package brightbox
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Log subsystems. The level of each can be set independently with
+// TF_LOG_PROVIDER_BRIGHTBOX_API, TF_LOG_PROVIDER_BRIGHTBOX_ORBIT and
+// TF_LOG_PROVIDER_BRIGHTBOX_WAIT.
+const (
+	apiSubsystem   = "api"
+	orbitSubsystem = "orbit"
+	waitSubsystem  = "wait"
+
+	logEnvPrefix = "TF_LOG_PROVIDER_BRIGHTBOX"
+)
+
+// Structured log field names
+const (
+	logFieldAccount      = "account"
+	logFieldObjectName   = "object_name"
+	logFieldResourceID   = "resource_id"
+	logFieldResourceType = "resource_type"
+	logFieldTarget       = "target"
+)
+
+var logSubsystems = []string{apiSubsystem, orbitSubsystem, waitSubsystem}
+
+// HTTP headers carrying credentials, logged by the subsystem transports
+var credentialHeaders = []string{"Authorization", "X-Auth-Token"}
+
+// contextWithSubsystems registers the provider log subsystems with the
+// context, each taking its level from its own environment variable
+func contextWithSubsystems(ctx context.Context) context.Context {
+	for _, subsystem := range logSubsystems {
+		ctx = tflog.NewSubsystem(ctx, subsystem, tflog.WithLevelFromEnv(logEnvPrefix, subsystem))
+		ctx = tflog.SubsystemMaskFieldValuesWithFieldKeys(ctx, subsystem, credentialHeaders...)
+	}
+	return ctx
+}
+
+// contextWithMaskedSecrets masks the values of every attribute marked
+// Sensitive in the schema, in both the root and subsystem loggers.
+// The values are masked wherever they appear, in their raw, JSON
+// escaped and base64 encoded forms, and the attribute names are used
+// to mask matching fields and JSON members in API request and
+// response bodies.
+func contextWithMaskedSecrets(ctx context.Context, attributes map[string]*schema.Schema, d *schema.ResourceData) context.Context {
+	var lookup func(string) interface{}
+	if d != nil {
+		lookup = d.Get
+	}
+	return contextWithMaskedValues(ctx, attributes, lookup)
+}
+
+// contextWithMaskedValues is contextWithMaskedSecrets taking the
+// attribute values from lookup, which may be nil
+func contextWithMaskedValues(ctx context.Context, attributes map[string]*schema.Schema, lookup func(string) interface{}) context.Context {
+	keys := sensitiveAttributes(attributes)
+	if len(keys) == 0 {
+		return ctx
+	}
+	var secrets []string
+	expressions := make([]*regexp.Regexp, 0, len(keys))
+	for _, key := range keys {
+		expressions = append(expressions, jsonMemberRegexp(key))
+		if lookup == nil {
+			continue
+		}
+		if value, ok := lookup(key).(string); ok && value != "" {
+			secrets = append(secrets, secretForms(value)...)
+		}
+	}
+	return contextWithMasks(ctx, keys, expressions, secrets)
+}
+
+// contextWithMaskedKeys masks the fields and JSON members named by keys,
+// for secrets whose values are not known until the API returns them
+func contextWithMaskedKeys(ctx context.Context, keys ...string) context.Context {
+	expressions := make([]*regexp.Regexp, 0, len(keys))
+	for _, key := range keys {
+		expressions = append(expressions, jsonMemberRegexp(key))
+	}
+	return contextWithMasks(ctx, keys, expressions, nil)
+}
+
+func contextWithMasks(ctx context.Context, keys []string, expressions []*regexp.Regexp, secrets []string) context.Context {
+	ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, keys...)
+	ctx = tflog.MaskLogRegexes(ctx, expressions...)
+	ctx = tflog.MaskLogStrings(ctx, secrets...)
+	for _, subsystem := range logSubsystems {
+		ctx = tflog.SubsystemMaskFieldValuesWithFieldKeys(ctx, subsystem, keys...)
+		ctx = tflog.SubsystemMaskLogRegexes(ctx, subsystem, expressions...)
+		ctx = tflog.SubsystemMaskLogStrings(ctx, subsystem, secrets...)
+	}
+	return ctx
+}
+
+// sensitiveAttributes lists the top level attributes marked Sensitive
+func sensitiveAttributes(attributes map[string]*schema.Schema) []string {
+	var result []string
+	for key, attribute := range attributes {
+		if attribute.Sensitive {
+			result = append(result, key)
+		}
+	}
+	return result
+}
+
+// secretForms returns the ways a secret value may appear in the logs
+func secretForms(value string) []string {
+	result := []string{value, base64.StdEncoding.EncodeToString([]byte(value))}
+	if escaped, err := json.Marshal(value); err == nil {
+		result = append(result, strings.Trim(string(escaped), `"`))
+	}
+	return result
+}
+
+// jsonMemberRegexp matches the string value of a JSON object member
+func jsonMemberRegexp(key string) *regexp.Regexp {
+	return regexp.MustCompile(fmt.Sprintf(`"%s"\s*:\s*"(?:[^"\\]|\\.)*"`, regexp.QuoteMeta(key)))
+}
+
+// contextWithLogging sets up the loggers for an operation upon a
+// resource or data source
+func contextWithLogging(ctx context.Context, resourceType string, resource *schema.Resource, d *schema.ResourceData) context.Context {
+	ctx = contextWithSubsystems(ctx)
+	ctx = contextWithMaskedSecrets(ctx, resource.Schema, d)
+	ctx = tflog.SetField(ctx, logFieldResourceType, resourceType)
+	if id := d.Id(); id != "" {
+		ctx = tflog.SetField(ctx, logFieldResourceID, id)
+	}
+	for _, subsystem := range logSubsystems {
+		ctx = tflog.SubsystemSetField(ctx, subsystem, logFieldResourceType, resourceType)
+		if id := d.Id(); id != "" {
+			ctx = tflog.SubsystemSetField(ctx, subsystem, logFieldResourceID, id)
+		}
+	}
+	return ctx
+}
+
+// contextWithFrameworkLogging sets up the loggers for a plugin
+// framework ephemeral resource or action, masking any secrets the API
+// returns under keys
+func contextWithFrameworkLogging(ctx context.Context, resourceType string, id string, keys ...string) context.Context {
+	ctx = contextWithSubsystems(ctx)
+	ctx = contextWithMaskedKeys(ctx, keys...)
+	ctx = tflog.SetField(ctx, logFieldResourceType, resourceType)
+	ctx = tflog.SetField(ctx, logFieldResourceID, id)
+	for _, subsystem := range logSubsystems {
+		ctx = tflog.SubsystemSetField(ctx, subsystem, logFieldResourceType, resourceType)
+		ctx = tflog.SubsystemSetField(ctx, subsystem, logFieldResourceID, id)
+	}
+	return ctx
+}
+
+// addLogging wraps the operations of a resource or data source so that
+// they log with structured fields and mask the values of its Sensitive
+// attributes
+func addLogging(resourceType string, resource *schema.Resource) {
+	resource.CreateContext = withLogging(resourceType, resource, resource.CreateContext)
+	resource.ReadContext = withLogging(resourceType, resource, resource.ReadContext)
+	resource.UpdateContext = withLogging(resourceType, resource, resource.UpdateContext)
+	resource.DeleteContext = withLogging(resourceType, resource, resource.DeleteContext)
+	if importer := resource.Importer; importer != nil && importer.StateContext != nil {
+		resource.Importer = &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				return importer.StateContext(contextWithLogging(ctx, resourceType, resource, d), d, meta)
+			},
+		}
+	}
+}
+
+func withLogging[F ~func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics](
+	resourceType string,
+	resource *schema.Resource,
+	operation F,
+) F {
+	if operation == nil {
+		return nil
+	}
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		return operation(contextWithLogging(ctx, resourceType, resource, d), d, meta)
+	}
+}
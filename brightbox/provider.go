@@ -15,60 +15,80 @@ const (
 	checkDelay         = 10 * time.Second
 )
 
+// providerSchema is the provider configuration schema, shared by the
+// SDKv2 and plugin framework halves of the provider
+func providerSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"account": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			DefaultFunc: schema.EnvDefaultFunc(accountEnvVar, nil),
+			Description: "Brightbox Cloud Account to operate upon",
+		},
+		"apiclient": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			DefaultFunc: schema.EnvDefaultFunc(clientEnvVar, defaultClientID),
+			Description: "Brightbox Cloud API Client/OAuth Application ID",
+		},
+		"apisecret": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Sensitive:   true,
+			DefaultFunc: schema.EnvDefaultFunc(clientSecretEnvVar, defaultClientSecret),
+			Description: "Brightbox Cloud API Client/OAuth Application Secret",
+		},
+		"apiurl": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			DefaultFunc: schema.EnvDefaultFunc(apiURLEnvVar, endpoint.DefaultBaseURL),
+			Description: "Brightbox Cloud Api URL for selected Region",
+		},
+		"orbit_url": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			DefaultFunc: schema.EnvDefaultFunc(orbitURLEnvVar, endpoint.DefaultOrbitBaseURL),
+			Description: "Brightbox Cloud Orbit URL for selected Region",
+		},
+		"password": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Sensitive:   true,
+			DefaultFunc: schema.EnvDefaultFunc(passwordEnvVar, nil),
+			Description: "Brightbox Cloud Password for User Name",
+		},
+		"username": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			DefaultFunc: schema.EnvDefaultFunc(usernameEnvVar, nil),
+			Description: "Brightbox Cloud User Name",
+		},
+	}
+}
+
 // Provider is the Brightbox Terraform driver root
 func Provider() *schema.Provider {
-	return &schema.Provider{
-		Schema: map[string]*schema.Schema{
-			"account": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				DefaultFunc: schema.EnvDefaultFunc(accountEnvVar, nil),
-				Description: "Brightbox Cloud Account to operate upon",
-			},
-			"apiclient": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				DefaultFunc: schema.EnvDefaultFunc(clientEnvVar, defaultClientID),
-				Description: "Brightbox Cloud API Client/OAuth Application ID",
-			},
-			"apisecret": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				DefaultFunc: schema.EnvDefaultFunc(clientSecretEnvVar, defaultClientSecret),
-				Description: "Brightbox Cloud API Client/OAuth Application Secret",
-			},
-			"apiurl": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				DefaultFunc: schema.EnvDefaultFunc(apiURLEnvVar, endpoint.DefaultBaseURL),
-				Description: "Brightbox Cloud Api URL for selected Region",
-			},
-			"orbit_url": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				DefaultFunc: schema.EnvDefaultFunc(orbitURLEnvVar, endpoint.DefaultOrbitBaseURL),
-				Description: "Brightbox Cloud Orbit URL for selected Region",
-			},
-			"password": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Sensitive:   true,
-				DefaultFunc: schema.EnvDefaultFunc(passwordEnvVar, nil),
-				Description: "Brightbox Cloud Password for User Name",
-			},
-			"username": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				DefaultFunc: schema.EnvDefaultFunc(usernameEnvVar, nil),
-				Description: "Brightbox Cloud User Name",
-			},
-		},
+	provider := &schema.Provider{
+		Schema: providerSchema(),
 		DataSourcesMap: map[string]*schema.Resource{
-			"brightbox_image":             dataSourceBrightboxImage(),
-			"brightbox_database_type":     dataSourceBrightboxDatabaseType(),
-			"brightbox_server_group":      dataSourceBrightboxServerGroup(),
-			"brightbox_server_type":       dataSourceBrightboxServerType(),
-			"brightbox_database_snapshot": dataSourceBrightboxDatabaseSnapshot(),
+			"brightbox_image":              dataSourceBrightboxImage(),
+			"brightbox_images":             dataSourceBrightboxImages(),
+			"brightbox_database_engines":   dataSourceBrightboxDatabaseEngines(),
+			"brightbox_database_type":      dataSourceBrightboxDatabaseType(),
+			"brightbox_server_group":       dataSourceBrightboxServerGroup(),
+			"brightbox_server_type":        dataSourceBrightboxServerType(),
+			"brightbox_server":             dataSourceBrightboxServer(),
+			"brightbox_servers":            dataSourceBrightboxServers(),
+			"brightbox_cloudip":            dataSourceBrightboxCloudIP(),
+			"brightbox_interface":          dataSourceBrightboxInterface(),
+			"brightbox_load_balancer":      dataSourceBrightboxLoadBalancer(),
+			"brightbox_firewall_policy":    dataSourceBrightboxFirewallPolicy(),
+			"brightbox_volume":             dataSourceBrightboxVolume(),
+			"brightbox_zone":               dataSourceBrightboxZone(),
+			"brightbox_zones":              dataSourceBrightboxZones(),
+			"brightbox_account":            dataSourceBrightboxAccount(),
+			"brightbox_database_snapshot":  dataSourceBrightboxDatabaseSnapshot(),
+			"brightbox_database_snapshots": dataSourceBrightboxDatabaseSnapshots(),
 		},
 		ResourcesMap: map[string]*schema.Resource{
 			"brightbox_server":                  resourceBrightboxServer(),
@@ -84,21 +104,49 @@ func Provider() *schema.Provider {
 			"brightbox_config_map":              resourceBrightboxConfigMap(),
 			"brightbox_volume":                  resourceBrightboxVolume(),
 		},
-		ConfigureContextFunc: providerConfigure,
 	}
+	provider.ConfigureContextFunc = func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+		ctx = contextWithSubsystems(ctx)
+		client, diags := providerConfigure(contextWithMaskedSecrets(ctx, provider.Schema, d), d)
+		for i := range diags {
+			diags[i].AttributePath = schemaAttributePath("provider", &schema.Resource{Schema: provider.Schema}, diags[i].AttributePath)
+		}
+		return client, diags
+	}
+	for name, dataSource := range provider.DataSourcesMap {
+		addDataSourceAccountOverride(dataSource)
+		addAttributePaths(name, dataSource)
+		addLogging(name, dataSource)
+		addDataSourceTracing(name, dataSource)
+	}
+	for name, resource := range provider.ResourcesMap {
+		addResourceAccountOverride(resource)
+		addResourceIdentity(name, resource)
+		addAttributePaths(name, resource)
+		addLogging(name, resource)
+		addResourceTracing(name, resource)
+	}
+	return provider
 }
 
 func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
-	return configureClient(
-		ctx,
-		authdetails{
-			APIClient: d.Get("apiclient").(string),
-			APISecret: d.Get("apisecret").(string),
-			UserName:  d.Get("username").(string),
-			password:  d.Get("password").(string),
-			Account:   d.Get("account").(string),
-			APIURL:    d.Get("apiurl").(string),
-			OrbitURL:  d.Get("orbit_url").(string),
-		},
-	)
+	return configureSharedClient(ctx, providerAuthDetails(d.Get))
+}
+
+// providerAuthDetails builds the client credentials from the provider
+// configuration values returned by lookup
+func providerAuthDetails(lookup func(string) interface{}) authdetails {
+	value := func(key string) string {
+		result, _ := lookup(key).(string)
+		return result
+	}
+	return authdetails{
+		APIClient: value("apiclient"),
+		APISecret: value("apisecret"),
+		UserName:  value("username"),
+		password:  value("password"),
+		Account:   value("account"),
+		APIURL:    value("apiurl"),
+		OrbitURL:  value("orbit_url"),
+	}
 }
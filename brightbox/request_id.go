@@ -0,0 +1,83 @@
+package brightbox
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+
+	brightbox "github.com/brightbox/gobrightbox/v2"
+)
+
+// requestIDHeader is the response header carrying the API's identifier
+// for a request, quoted when contacting support
+const requestIDHeader = "X-Request-Id"
+
+// requestIDError is an API error along with the ID the API gave the
+// failed request. Its message is that of the API error.
+type requestIDError struct {
+	apiError  *brightbox.APIError
+	requestID string
+}
+
+func (e *requestIDError) Error() string {
+	return e.apiError.Error()
+}
+
+// Unwrap returns the API error, so errors.As finds either
+func (e *requestIDError) Unwrap() error {
+	return e.apiError
+}
+
+// requestIDTransport turns failed API requests into a requestIDError.
+// An APIError keeps neither the response headers nor the request, so
+// the request ID has to be captured before the API client sees the
+// response. Token requests carry no bearer token and are left for the
+// OAuth2 library to report.
+type requestIDTransport struct {
+	transport http.RoundTripper
+}
+
+func newRequestIDTransport(transport http.RoundTripper) http.RoundTripper {
+	return &requestIDTransport{transport: transport}
+}
+
+// RoundTrip implements http.RoundTripper
+func (t *requestIDTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.transport.RoundTrip(req)
+	if err != nil || resp.StatusCode < http.StatusBadRequest ||
+		!strings.HasPrefix(req.Header.Get("Authorization"), "Bearer ") {
+		return resp, err
+	}
+	requestID := resp.Header.Get(requestIDHeader)
+	if requestID == "" {
+		return resp, nil
+	}
+	defer resp.Body.Close()
+	return nil, &requestIDError{apiError: newAPIError(resp), requestID: requestID}
+}
+
+// newAPIError reads a failed response the way the API client library
+// does
+func newAPIError(resp *http.Response) *brightbox.APIError {
+	apiError := brightbox.APIError{
+		RequestURL: resp.Request.URL,
+		StatusCode: resp.StatusCode,
+		Status:     resp.Status,
+	}
+	apiError.ResponseBody, apiError.ParseError = io.ReadAll(resp.Body)
+	if len(apiError.ResponseBody) > 0 {
+		apiError.ParseError = json.Unmarshal(apiError.ResponseBody, &apiError)
+	}
+	return &apiError
+}
+
+// apiErrorRequestID returns the request ID carried by an error, if any
+func apiErrorRequestID(err error) string {
+	var requestErr *requestIDError
+	if errors.As(err, &requestErr) {
+		return requestErr.requestID
+	}
+	return ""
+}
@@ -16,7 +16,14 @@ func resourceBrightboxAPIClient() *schema.Resource {
 		UpdateContext: resourceBrightboxAPIClientUpdate,
 		DeleteContext: resourceBrightboxAPIClientDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: resourceBrightboxImport(
+				(*brightbox.Client).APIClients,
+				"API Client",
+				func(v brightbox.APIClient) string { return v.ID },
+				func(v brightbox.APIClient) string { return v.Name },
+				nil,
+				apiClientRevoked,
+			),
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -27,9 +34,12 @@ func resourceBrightboxAPIClient() *schema.Resource {
 		Schema: map[string]*schema.Schema{
 
 			"account": {
-				Description: "The account the API client relates to",
-				Type:        schema.TypeString,
-				Computed:    true,
+				Description:  "The account the API client relates to",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(accountRegexp, "must be a valid account ID"),
 			},
 
 			"description": {
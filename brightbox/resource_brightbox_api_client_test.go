@@ -169,7 +169,7 @@ func init() {
 			defer cancel()
 			client, errs := obtainCloudClient()
 			if errs != nil {
-				return fmt.Errorf(errs[0].Summary)
+				return fmt.Errorf("%s", errs[0].Summary)
 			}
 			apiClients, err := client.APIClient.APIClients(ctx)
 			if err != nil {
@@ -4,7 +4,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
-	"log"
+	"net"
 	"strings"
 	"time"
 
@@ -12,6 +12,7 @@ import (
 	"github.com/brightbox/gobrightbox/v2/enums/cloudipstatus"
 	"github.com/brightbox/gobrightbox/v2/enums/mode"
 	"github.com/brightbox/gobrightbox/v2/enums/transportprotocol"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -26,7 +27,7 @@ func resourceBrightboxCloudIP() *schema.Resource {
 		UpdateContext: resourceBrightboxCloudIPUpdateAndRemap,
 		DeleteContext: resourceBrightboxCloudIPUnassignAndDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: resourceBrightboxCloudIPImport,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -258,7 +259,6 @@ func setCloudIPAttributes(
 			diags = append(diags, diag.Errorf("unexpected: %s", err)...)
 		}
 	}
-	log.Printf("[DEBUG] PortTranslator details are %#v", cloudipInstance.PortTranslators)
 	portTranslators := make([]map[string]interface{}, len(cloudipInstance.PortTranslators))
 	for i, portTranslator := range cloudipInstance.PortTranslators {
 		portTranslators[i] = map[string]interface{}{
@@ -294,7 +294,7 @@ func resourceBrightboxCloudIPCreateAndAssign(
 	if err != nil {
 		return brightboxFromErrSlice(err)
 	}
-	log.Printf("[DEBUG] setting details from returned object")
+	tflog.Debug(ctx, "Setting details from returned object")
 	return setCloudIPAttributes(d, cloudIPInstance)
 }
 
@@ -305,7 +305,9 @@ func assignCloudIP(
 	target string,
 	timeout time.Duration,
 ) (*brightbox.CloudIP, error) {
-	log.Printf("[INFO] Attaching %s to %s", d.Id(), target)
+	tflog.Info(ctx, "Attaching Cloud IP", map[string]interface{}{
+		logFieldTarget: target,
+	})
 	client := meta.(*CompositeClient).APIClient
 	return assuredMapCloudIP(
 		ctx,
@@ -325,7 +327,9 @@ func unassignCloudIP(
 	client := meta.(*CompositeClient).APIClient
 	targetID, _ := d.GetChange("target")
 	if targetID.(string) != "" {
-		log.Printf("[INFO] Detaching %s from %s", d.Id(), targetID.(string))
+		tflog.Info(ctx, "Detaching Cloud IP", map[string]interface{}{
+			logFieldTarget: targetID,
+		})
 		_, err := assuredUnmapCloudIP(
 			ctx,
 			client,
@@ -333,10 +337,10 @@ func unassignCloudIP(
 			timeout,
 		)
 		if err == nil {
-			log.Printf("[DEBUG] detached cleanly")
+			tflog.Debug(ctx, "Detached cleanly")
 			return nil
 		}
-		log.Printf("[DEBUG] detachment failed - checking for out of band detachment")
+		tflog.Debug(ctx, "Detachment failed - checking for out of band detachment")
 		instance, readerr := client.CloudIP(ctx, d.Id())
 		if readerr != nil {
 			return diag.FromErr(readerr)
@@ -344,7 +348,7 @@ func unassignCloudIP(
 		if !detachedCloudIP(instance) {
 			return brightboxFromErrSlice(err)
 		}
-		log.Printf("[DEBUG] detached out of band")
+		tflog.Debug(ctx, "Detached out of band")
 	}
 	return nil
 }
@@ -365,7 +369,7 @@ func resourceBrightboxCloudIPUpdateAndRemap(
 	var diags diag.Diagnostics
 
 	if d.HasChange("target") {
-		log.Printf("[INFO] Cloud IP target has changed, updating...")
+		tflog.Info(ctx, "Cloud IP target has changed, updating")
 		diags = append(diags, unassignCloudIP(ctx, d, meta, d.Timeout(schema.TimeoutUpdate))...)
 		if targetID, ok := d.GetOk("target"); ok {
 			if target := targetID.(string); target != "" {
@@ -386,15 +390,25 @@ func assuredMapCloudIP(
 	targetID string,
 	timeout time.Duration,
 ) (*brightbox.CloudIP, error) {
-	_, err := client.MapCloudIP(
-		ctx,
-		cloudipID,
-		brightbox.CloudIPAttachment{Destination: targetID},
-	)
+	start := time.Now()
+	_, err := retryOnConflict(ctx, timeout, func() (*brightbox.CloudIP, error) {
+		return client.MapCloudIP(
+			ctx,
+			cloudipID,
+			brightbox.CloudIPAttachment{Destination: targetID},
+		)
+	})
 	if err != nil {
 		return nil, fmt.Errorf("Error assigning Cloud IP %s to target %s: %s", cloudipID, targetID, err)
 	}
-	return waitForMappedCloudIP(ctx, client, cloudipID, timeout)
+	remaining := timeout - time.Since(start)
+	if remaining <= 0 {
+		return nil, &retry.TimeoutError{
+			Timeout:       timeout,
+			ExpectedState: []string{cloudipstatus.Mapped.String()},
+		}
+	}
+	return waitForMappedCloudIP(ctx, client, cloudipID, remaining)
 }
 
 func assuredUnmapCloudIP(
@@ -418,6 +432,11 @@ func waitForCloudIP(
 	pending string,
 	target string,
 ) (*brightbox.CloudIP, error) {
+	tflog.SubsystemDebug(ctx, waitSubsystem, "Waiting for Cloud IP", map[string]interface{}{
+		logFieldResourceID: cloudipID,
+		"pending":          pending,
+		"target_state":     target,
+	})
 	stateConf := retry.StateChangeConf{
 		Pending:    []string{pending},
 		Target:     []string{target},
@@ -426,7 +445,7 @@ func waitForCloudIP(
 		MinTimeout: minimumRefreshWait,
 	}
 
-	activeCloudIP, err := stateConf.WaitForStateContext(ctx)
+	activeCloudIP, err := waitForState(ctx, &stateConf)
 	if err != nil {
 		return nil, err
 	}
@@ -462,9 +481,16 @@ func cloudipStateRefresh(ctx context.Context, client *brightbox.Client, cloudipI
 	return func() (interface{}, string, error) {
 		cloudipInstance, err := client.CloudIP(ctx, cloudipID)
 		if err != nil {
-			log.Printf("Error on Cloud IP State Refresh: %s", err)
+			tflog.SubsystemError(ctx, waitSubsystem, "Error on Cloud IP State Refresh", map[string]interface{}{
+				logFieldResourceID: cloudipID,
+				"error":            err.Error(),
+			})
 			return nil, "", err
 		}
+		tflog.SubsystemTrace(ctx, waitSubsystem, "Cloud IP State Refresh", map[string]interface{}{
+			logFieldResourceID: cloudipID,
+			"status":           cloudipInstance.Status.String(),
+		})
 		return cloudipInstance, cloudipInstance.Status.String(), nil
 	}
 }
@@ -481,6 +507,50 @@ func resourceBrightboxCloudIPUnassignAndDelete(
 	return resourceBrightboxCloudIPDelete(ctx, d, meta)
 }
 
+// resourceBrightboxCloudIPImport accepts a Cloud IP ID, name or FQDN,
+// or one of its public addresses
+func resourceBrightboxCloudIPImport(
+	ctx context.Context,
+	d *schema.ResourceData,
+	meta interface{},
+) ([]*schema.ResourceData, error) {
+	imported, err := resourceBrightboxImport(
+		(*brightbox.Client).CloudIPs,
+		"Cloud IP",
+		func(v brightbox.CloudIP) string { return v.ID },
+		func(v brightbox.CloudIP) string { return v.Name },
+		func(v brightbox.CloudIP) []string { return cloudIPHostnames([]brightbox.CloudIP{v}) },
+		nil,
+	)(ctx, d, meta)
+	if err != nil {
+		return nil, err
+	}
+	address := net.ParseIP(d.Id())
+	if address == nil {
+		return imported, nil
+	}
+	client := meta.(*CompositeClient).APIClient
+	tflog.Debug(ctx, "Looking up Cloud IP by address", map[string]interface{}{
+		"address": address.String(),
+	})
+	cloudIPs, err := client.CloudIPs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var matcher func(brightbox.CloudIP) bool
+	if address.To4() != nil {
+		matcher = cloudIPAddressMatcher(address, nil)
+	} else {
+		matcher = cloudIPAddressMatcher(nil, address)
+	}
+	results := filter(cloudIPs, matcher)
+	if len(results) != 1 {
+		return nil, fmt.Errorf("No Cloud IP found with address %s", address)
+	}
+	d.SetId(results[0].ID)
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceBrightboxPortTranslationHash(
 	v interface{},
 ) int {
@@ -10,6 +10,7 @@ import (
 	"github.com/brightbox/gobrightbox/v2/enums/cloudipstatus"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 func TestAccBrightboxCloudip_Basic(t *testing.T) {
@@ -42,10 +43,26 @@ func TestAccBrightboxCloudip_Basic(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccBrightboxCloudIPAddress(resourceName),
+			},
 		},
 	})
 }
 
+func testAccBrightboxCloudIPAddress(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", n)
+		}
+		return rs.Primary.Attributes["public_ipv4"], nil
+	}
+}
+
 func TestAccBrightboxCloudip_clear_name(t *testing.T) {
 	var cloudIPInstance brightbox.CloudIP
 	resourceName := "brightbox_cloudip.foobar"
@@ -423,7 +440,7 @@ func init() {
 			defer cancel()
 			client, errs := obtainCloudClient()
 			if errs != nil {
-				return fmt.Errorf(errs[0].Summary)
+				return fmt.Errorf("%s", errs[0].Summary)
 			}
 			objects, err := client.APIClient.CloudIPs(ctx)
 			if err != nil {
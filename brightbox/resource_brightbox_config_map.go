@@ -15,7 +15,14 @@ func resourceBrightboxConfigMap() *schema.Resource {
 		UpdateContext: resourceBrightboxConfigMapUpdate,
 		DeleteContext: resourceBrightboxConfigMapDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: resourceBrightboxImport(
+				(*brightbox.Client).ConfigMaps,
+				"Config Map",
+				func(v brightbox.ConfigMap) string { return v.ID },
+				func(v brightbox.ConfigMap) string { return v.Name },
+				nil,
+				nil,
+			),
 		},
 
 		Timeouts: &schema.ResourceTimeout{
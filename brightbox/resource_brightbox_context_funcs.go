@@ -3,9 +3,12 @@ package brightbox
 import (
 	"context"
 	"errors"
-	"log"
+	"fmt"
+	"slices"
+	"strings"
 
 	brightbox "github.com/brightbox/gobrightbox/v2"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -20,18 +23,18 @@ func resourceBrightboxCreate[O, I any](
 	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 		client := meta.(*CompositeClient).APIClient
 
-		log.Printf("[INFO] Creating %s", objectName)
+		ctx = tflog.SetField(ctx, logFieldObjectName, objectName)
+		tflog.Info(ctx, "Creating object")
 		var objectOptions I
 		errs := updater(d, &objectOptions)
 		if errs.HasError() {
 			return errs
 		}
-		log.Printf("[INFO] %s create configuration: %+v", objectName, objectOptions)
 		object, err := creator(client, ctx, objectOptions)
 		if err != nil {
 			return brightboxFromErrSlice(err)
 		}
-		log.Printf("[DEBUG] setting details from returned object: %+v", *object)
+		tflog.Debug(ctx, "Setting details from returned object")
 		return setter(d, object)
 	}
 }
@@ -61,14 +64,19 @@ func resourceBrightboxReadStatus[O any](
 		target := d.Id()
 		client := meta.(*CompositeClient).APIClient
 
-		log.Printf("[DEBUG] %s resource read called for %s", objectName, target)
+		ctx = tflog.SetField(ctx, logFieldObjectName, objectName)
+		tflog.Debug(ctx, "Resource read called", map[string]interface{}{
+			logFieldResourceID: target,
+		})
 
 		object, err := reader(client, ctx, target)
 		if err != nil {
 			var apierror *brightbox.APIError
 			if !d.IsNewResource() && errors.As(err, &apierror) {
 				if apierror.StatusCode == 404 {
-					log.Printf("[WARN] %s not found, removing from state: %s", objectName, target)
+					tflog.Warn(ctx, "Object not found, removing from state", map[string]interface{}{
+						logFieldResourceID: target,
+					})
 					d.SetId("")
 					return nil
 				}
@@ -76,12 +84,14 @@ func resourceBrightboxReadStatus[O any](
 			return brightboxFromErrSlice(err)
 		}
 		if missing(object) {
-			log.Printf("[WARN] %s not found, removing from state: %s", objectName, target)
+			tflog.Warn(ctx, "Object not found, removing from state", map[string]interface{}{
+				logFieldResourceID: target,
+			})
 			d.SetId("")
 			return nil
 		}
 
-		log.Printf("[DEBUG] setting details from returned object: %+v", *object)
+		tflog.Debug(ctx, "Setting details from returned object")
 		return setter(d, object)
 	}
 }
@@ -95,7 +105,8 @@ func datasourceBrightboxRead[O any](
 	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 		client := meta.(*CompositeClient).APIClient
 
-		log.Printf("[DEBUG] %s data read called. Retrieving object list", objectName)
+		ctx = tflog.SetField(ctx, logFieldObjectName, objectName)
+		tflog.Debug(ctx, "Data read called. Retrieving object list")
 
 		objects, err := reader(client, ctx)
 		if err != nil {
@@ -119,13 +130,124 @@ func datasourceBrightboxRead[O any](
 		}
 
 		result := &results[0]
-		log.Printf("[DEBUG] Single %s found", objectName)
-		log.Printf("[DEBUG] %+v", *result)
+		tflog.Debug(ctx, "Single object found")
 		return setter(d, result)
 
 	}
 }
 
+// Prefixes of the import IDs resolved through the list endpoint
+const (
+	importNamePrefix = "name:"
+	importFQDNPrefix = "fqdn:"
+)
+
+// resourceBrightboxImport returns an importer accepting an object ID,
+// or "name:<label>" or "fqdn:<host>" resolved to exactly one object
+// through the list endpoint. hostnames is nil if the object has none,
+// and missing is nil if the object has no status.
+func resourceBrightboxImport[O any](
+	reader func(*brightbox.Client, context.Context) ([]O, error),
+	objectName string,
+	identify func(O) string,
+	name func(O) string,
+	hostnames func(O) []string,
+	missing func(*O) bool,
+) schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+		var findFunc func(O) bool
+		var criteria string
+		if label, ok := strings.CutPrefix(d.Id(), importNamePrefix); ok {
+			criteria = fmt.Sprintf("name %q", label)
+			findFunc = func(object O) bool { return name(object) == label }
+		} else if host, ok := strings.CutPrefix(d.Id(), importFQDNPrefix); ok {
+			if hostnames == nil {
+				return nil, fmt.Errorf("Import by FQDN is not supported for %s objects. Please use an ID or %s<label>", objectName, importNamePrefix)
+			}
+			host = strings.TrimSuffix(host, ".")
+			criteria = fmt.Sprintf("FQDN %q", host)
+			findFunc = func(object O) bool {
+				return slices.ContainsFunc(hostnames(object), func(v string) bool {
+					return strings.EqualFold(strings.TrimSuffix(v, "."), host)
+				})
+			}
+		} else {
+			return []*schema.ResourceData{d}, nil
+		}
+		client := meta.(*CompositeClient).APIClient
+
+		ctx = tflog.SetField(ctx, logFieldObjectName, objectName)
+		tflog.Debug(ctx, "Import called. Retrieving object list", map[string]interface{}{
+			logFieldTarget: d.Id(),
+		})
+
+		objects, err := reader(client, ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		// Deleted and failed objects linger in the API for a while, but
+		// read as gone, so cannot be imported
+		results := filter(objects, func(object O) bool {
+			return findFunc(object) && (missing == nil || !missing(&object))
+		})
+
+		if len(results) > 1 {
+			return nil, fmt.Errorf("More than one %s found with %s (%s). Please import by ID instead",
+				objectName, criteria, strings.Join(idList(results, identify), ", "))
+		}
+		if len(results) < 1 {
+			return nil, fmt.Errorf("No %s found with %s", objectName, criteria)
+		}
+
+		tflog.Debug(ctx, "Single object found")
+		d.SetId(identify(results[0]))
+		return []*schema.ResourceData{d}, nil
+	}
+}
+
+// cloudIPHostnames returns the hostnames of a set of Cloud IPs
+func cloudIPHostnames(cloudIPs []brightbox.CloudIP) []string {
+	var result []string
+	for _, cloudIP := range cloudIPs {
+		result = append(result, cloudIP.Fqdn)
+		if cloudIP.ReverseDNS != "" {
+			result = append(result, cloudIP.ReverseDNS)
+		}
+	}
+	return result
+}
+
+func datasourceBrightboxListRead[O any](
+	reader func(*brightbox.Client, context.Context) ([]O, error),
+	objectName string,
+	setter func(*schema.ResourceData, []O) diag.Diagnostics,
+	finderGenerator func(*schema.ResourceData) (func(O) bool, diag.Diagnostics),
+) schema.ReadContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		client := meta.(*CompositeClient).APIClient
+
+		ctx = tflog.SetField(ctx, logFieldObjectName, objectName)
+		tflog.Debug(ctx, "Data read called. Retrieving object list")
+
+		objects, err := reader(client, ctx)
+		if err != nil {
+			return brightboxFromErrSlice(err)
+		}
+
+		findFunc, errs := finderGenerator(d)
+		if errs.HasError() {
+			return errs
+		}
+
+		results := filter(objects, findFunc)
+		tflog.Debug(ctx, "Objects found", map[string]interface{}{
+			"count": len(results),
+		})
+		return setter(d, results)
+	}
+}
+
 func datasourceBrightboxRecentRead[O brightbox.CreateDated](
 	reader func(*brightbox.Client, context.Context) ([]O, error),
 	objectName string,
@@ -135,7 +257,8 @@ func datasourceBrightboxRecentRead[O brightbox.CreateDated](
 	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 		client := meta.(*CompositeClient).APIClient
 
-		log.Printf("[DEBUG] %s data read called. Retrieving object list", objectName)
+		ctx = tflog.SetField(ctx, logFieldObjectName, objectName)
+		tflog.Debug(ctx, "Data read called. Retrieving object list")
 
 		objects, err := reader(client, ctx)
 		if err != nil {
@@ -157,7 +280,9 @@ func datasourceBrightboxRecentRead[O brightbox.CreateDated](
 				return diag.Errorf("Your query returned more than one result (found %d entries). Please try a more "+
 					"specific search criteria.", len(results))
 			}
-			log.Printf("[DEBUG] Multiple results found and `most_recent` is set")
+			tflog.Debug(ctx, "Multiple results found and `most_recent` is set", map[string]interface{}{
+				"count": len(results),
+			})
 			result = mostRecent(results)
 		} else if len(results) < 1 {
 			return diag.Errorf("Your query returned no results. " +
@@ -165,8 +290,57 @@ func datasourceBrightboxRecentRead[O brightbox.CreateDated](
 		} else {
 			result = &results[0]
 		}
-		log.Printf("[DEBUG] Single %s found", objectName)
-		log.Printf("[DEBUG] %+v", *result)
+		tflog.Debug(ctx, "Single object found")
+		return setter(d, result)
+
+	}
+}
+
+// datasourceBrightboxSmallestRead allows a query to return more than one
+// result if `select` is set to "smallest", choosing the least of them
+func datasourceBrightboxSmallestRead[O any](
+	reader func(*brightbox.Client, context.Context) ([]O, error),
+	objectName string,
+	setter func(*schema.ResourceData, *O) diag.Diagnostics,
+	finderGenerator func(*schema.ResourceData) (func(O) bool, diag.Diagnostics),
+	less func(a, b O) bool,
+) schema.ReadContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		client := meta.(*CompositeClient).APIClient
+
+		ctx = tflog.SetField(ctx, logFieldObjectName, objectName)
+		tflog.Debug(ctx, "Data read called. Retrieving object list")
+
+		objects, err := reader(client, ctx)
+		if err != nil {
+			return brightboxFromErrSlice(err)
+		}
+
+		findFunc, errs := finderGenerator(d)
+		if errs.HasError() {
+			return errs
+		}
+
+		results := filter(objects, findFunc)
+
+		var result *O
+
+		if len(results) > 1 {
+			if d.Get("select").(string) != selectSmallest {
+				return diag.Errorf("Your query returned more than one result (found %d entries). Please try a more "+
+					"specific search criteria, or set `select` to %q.", len(results), selectSmallest)
+			}
+			tflog.Debug(ctx, "Multiple results found and `select` is smallest", map[string]interface{}{
+				"count": len(results),
+			})
+			result = smallest(results, less)
+		} else if len(results) < 1 {
+			return diag.Errorf("Your query returned no results. " +
+				"Please change your search criteria and try again.")
+		} else {
+			result = &results[0]
+		}
+		tflog.Debug(ctx, "Single object found")
 		return setter(d, result)
 
 	}
@@ -200,13 +374,14 @@ func resourceBrightboxUpdateWithLock[O, I any](
 		if errs.HasError() {
 			return errs
 		}
-		log.Printf("[DEBUG] %s update configuration: %+v", objectName, objectOpts)
+		ctx = tflog.SetField(ctx, logFieldObjectName, objectName)
+		tflog.Info(ctx, "Updating object")
 
 		object, err := putter(client, ctx, *objectOpts)
 		if err != nil {
 			return brightboxFromErrSlice(err)
 		}
-		log.Printf("[DEBUG] setting details from returned object: %+v", *object)
+		tflog.Debug(ctx, "Setting details from returned object")
 		if locksetter != nil && d.HasChange("locked") {
 			return locksetter(ctx, d, meta)
 		}
@@ -221,12 +396,13 @@ func resourceBrightboxDelete[O any](
 	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 		client := meta.(*CompositeClient).APIClient
 
-		log.Printf("[INFO] Deleting %s %s", objectName, d.Id())
+		ctx = tflog.SetField(ctx, logFieldObjectName, objectName)
+		tflog.Info(ctx, "Deleting object")
 		_, err := deleter(client, ctx, d.Id())
 		if err != nil {
 			return brightboxFromErrSlice(err)
 		}
-		log.Printf("[DEBUG] Deleted cleanly")
+		tflog.Debug(ctx, "Deleted cleanly")
 		return nil
 	}
 }
@@ -245,6 +421,10 @@ func resourceBrightboxDeleteAndWait[O any](
 		}
 
 		client := meta.(*CompositeClient).APIClient
+		tflog.SubsystemInfo(ctx, waitSubsystem, "Waiting for object to be removed", map[string]interface{}{
+			logFieldObjectName: objectName,
+			logFieldResourceID: d.Id(),
+		})
 		stateConf := retry.StateChangeConf{
 			Pending:    pending,
 			Target:     target,
@@ -253,7 +433,7 @@ func resourceBrightboxDeleteAndWait[O any](
 			Delay:      checkDelay,
 			MinTimeout: minimumRefreshWait,
 		}
-		_, err := stateConf.WaitForStateContext(ctx)
+		_, err := waitForState(ctx, &stateConf)
 		if err != nil {
 			return brightboxFromErrSlice(err)
 		}
@@ -270,7 +450,9 @@ func resourceBrightboxSetLockState[O any](
 	return func(ctx context.Context, d *schema.ResourceData, meta interface{},
 	) diag.Diagnostics {
 		locked := d.Get("locked").(bool)
-		log.Printf("[INFO] Setting lock state to %v", locked)
+		tflog.Info(ctx, "Setting lock state", map[string]interface{}{
+			"locked": locked,
+		})
 		client := meta.(*CompositeClient).APIClient
 		var object *O
 		var err error
@@ -282,7 +464,7 @@ func resourceBrightboxSetLockState[O any](
 		if err != nil {
 			return brightboxFromErrSlice(err)
 		}
-		log.Printf("[DEBUG] setting details from returned object: %+v", *object)
+		tflog.Debug(ctx, "Setting details from returned object")
 		return setter(d, object)
 	}
 }
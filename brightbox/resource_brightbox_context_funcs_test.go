@@ -4,7 +4,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/http"
 	"strings"
+	"testing"
 
 	brightbox "github.com/brightbox/gobrightbox/v2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -85,3 +87,64 @@ func testAccCheckBrightboxObjectExists[O any](
 		return nil
 	}
 }
+
+func TestServerImportByName(t *testing.T) {
+	client := newFakeAPIClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[
+			{"id":"srv-aaaaa","name":"web","status":"active","fqdn":"srv-aaaaa.gb1.brightbox.com",
+				"cloud_ips":[{"id":"cip-aaaaa","fqdn":"cip-aaaaa.gb1.brightbox.com","reverse_dns":"www.example.com"}]},
+			{"id":"srv-bbbbb","name":"db","status":"active","fqdn":"srv-bbbbb.gb1.brightbox.com"},
+			{"id":"srv-ccccc","name":"db","status":"inactive","fqdn":"srv-ccccc.gb1.brightbox.com"},
+			{"id":"srv-eeeee","name":"web","status":"deleted","fqdn":"srv-eeeee.gb1.brightbox.com"},
+			{"id":"srv-fffff","name":"old","status":"failed","fqdn":"srv-fffff.gb1.brightbox.com"}
+		]`))
+	})
+	meta := &CompositeClient{APIClient: client}
+	testCases := []struct {
+		in  string
+		out string
+		err string
+	}{
+		{"srv-ddddd", "srv-ddddd", ""},
+		{"name:web", "srv-aaaaa", ""},
+		{"name:db", "", "More than one Server found with name \"db\" (srv-bbbbb, srv-ccccc)"},
+		{"name:mail", "", "No Server found with name \"mail\""},
+		{"name:old", "", "No Server found with name \"old\""},
+		{"fqdn:srv-eeeee.gb1.brightbox.com", "", "No Server found with FQDN \"srv-eeeee.gb1.brightbox.com\""},
+		{"fqdn:srv-bbbbb.gb1.brightbox.com", "srv-bbbbb", ""},
+		{"fqdn:ipv6.srv-ccccc.gb1.brightbox.com.", "srv-ccccc", ""},
+		{"fqdn:WWW.example.com", "srv-aaaaa", ""},
+		{"fqdn:cip-aaaaa.gb1.brightbox.com", "srv-aaaaa", ""},
+		{"fqdn:mail.example.com", "", "No Server found with FQDN \"mail.example.com\""},
+	}
+	for _, tcase := range testCases {
+		resource := resourceBrightboxServer()
+		d := resource.TestResourceData()
+		d.SetId(tcase.in)
+		result, err := resource.Importer.StateContext(context.Background(), d, meta)
+		if tcase.err != "" {
+			if err == nil || !strings.Contains(err.Error(), tcase.err) {
+				t.Errorf("%s: expected error %q, got %v", tcase.in, tcase.err, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", tcase.in, err)
+			continue
+		}
+		if result[0].Id() != tcase.out {
+			t.Errorf("%s: expected %s, got %s", tcase.in, tcase.out, result[0].Id())
+		}
+	}
+}
+
+func TestImportByFQDNUnsupported(t *testing.T) {
+	resource := resourceBrightboxServerGroup()
+	d := resource.TestResourceData()
+	d.SetId("fqdn:www.example.com")
+	_, err := resource.Importer.StateContext(context.Background(), d, &CompositeClient{})
+	if err == nil || !strings.Contains(err.Error(), "Import by FQDN is not supported") {
+		t.Errorf("expected an FQDN error, got %v", err)
+	}
+}
@@ -2,11 +2,11 @@ package brightbox
 
 import (
 	"context"
-	"log"
 	"time"
 
 	brightbox "github.com/brightbox/gobrightbox/v2"
 	"github.com/brightbox/gobrightbox/v2/enums/databaseserverstatus"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -20,8 +20,18 @@ func resourceBrightboxDatabaseServer() *schema.Resource {
 		ReadContext:   resourceBrightboxDatabaseServerRead,
 		UpdateContext: resourceBrightboxDatabaseServerResizeAndUpdate,
 		DeleteContext: resourceBrightboxDatabaseServerDeleteAndWait,
+		ValidateRawResourceConfigFuncs: []schema.ValidateRawResourceConfigFunc{
+			validateDatabaseEngineVersion,
+		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: resourceBrightboxImport(
+				(*brightbox.Client).DatabaseServers,
+				"Database Server",
+				func(v brightbox.DatabaseServer) string { return v.ID },
+				func(v brightbox.DatabaseServer) string { return v.Name },
+				func(v brightbox.DatabaseServer) []string { return cloudIPHostnames(v.CloudIPs) },
+				databaseServerUnavailable,
+			),
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -77,7 +87,7 @@ func resourceBrightboxDatabaseServer() *schema.Resource {
 			},
 
 			"database_version": {
-				Description:  "The version of the given engine in use",
+				Description:  "The version of the given engine in use. See the brightbox_database_engines data source for supported versions",
 				Type:         schema.TypeString,
 				Optional:     true,
 				Computed:     true,
@@ -309,9 +319,16 @@ func databaseServerStateRefresh(client *brightbox.Client, ctx context.Context, d
 	return func() (interface{}, string, error) {
 		databaseServer, err := client.DatabaseServer(ctx, databaseServerID)
 		if err != nil {
-			log.Printf("Error on Database Server State Refresh: %s", err)
+			tflog.SubsystemError(ctx, waitSubsystem, "Error on Database Server State Refresh", map[string]interface{}{
+				logFieldResourceID: databaseServerID,
+				"error":            err.Error(),
+			})
 			return nil, "", err
 		}
+		tflog.SubsystemTrace(ctx, waitSubsystem, "Database Server State Refresh", map[string]interface{}{
+			logFieldResourceID: databaseServerID,
+			"status":           databaseServer.Status.String(),
+		})
 		return databaseServer, databaseServer.Status.String(), nil
 	}
 }
@@ -323,10 +340,12 @@ func resourceBrightboxDatabaseServerResizeAndUpdate(
 ) diag.Diagnostics {
 	client := meta.(*CompositeClient).APIClient
 
-	log.Printf("[DEBUG] Database Resize and Update called for %s", d.Id())
+	tflog.Debug(ctx, "Database Resize and Update called")
 	if d.HasChange("database_type") {
 		newDatabaseType := d.Get("database_type").(string)
-		log.Printf("[INFO] Changing database type to %v", newDatabaseType)
+		tflog.Info(ctx, "Changing database type", map[string]interface{}{
+			"database_type": newDatabaseType,
+		})
 		_, err := client.ResizeDatabaseServer(
 			ctx,
 			d.Id(),
@@ -346,7 +365,7 @@ func resourceBrightboxDatabaseServerCreateAndWait(
 ) diag.Diagnostics {
 	client := meta.(*CompositeClient).APIClient
 
-	log.Printf("[INFO]] Creating Database Server")
+	tflog.Info(ctx, "Creating Database Server")
 	var databaseServerOpts brightbox.DatabaseServerOptions
 
 	errs := addUpdateableDatabaseServerOptions(d, &databaseServerOpts)
@@ -365,8 +384,7 @@ func resourceBrightboxDatabaseServerCreateAndWait(
 	zone := &databaseServerOpts.Zone
 	assignString(d, &zone, "zone")
 
-	log.Printf("[DEBUG] Database Server create configuration %+v", databaseServerOpts)
-	outputDatabaseServerOptions(&databaseServerOpts)
+	outputDatabaseServerOptions(ctx, &databaseServerOpts)
 
 	databaseServer, err := client.CreateDatabaseServer(ctx, databaseServerOpts)
 	if err != nil {
@@ -376,12 +394,16 @@ func resourceBrightboxDatabaseServerCreateAndWait(
 	d.SetId(databaseServer.ID)
 
 	if databaseServer.AdminPassword == "" {
-		log.Printf("[WARN] No password returned for Cloud SQL server %s", databaseServer.ID)
+		tflog.Warn(ctx, "No password returned for Cloud SQL server", map[string]interface{}{
+			logFieldResourceID: databaseServer.ID,
+		})
 	} else {
 		d.Set("admin_password", databaseServer.AdminPassword)
 	}
 
-	log.Printf("[INFO] Waiting for Database Server (%s) to become available", d.Id())
+	tflog.SubsystemInfo(ctx, waitSubsystem, "Waiting for Database Server to become available", map[string]interface{}{
+		logFieldResourceID: d.Id(),
+	})
 
 	stateConf := retry.StateChangeConf{
 		Pending: []string{
@@ -395,7 +417,7 @@ func resourceBrightboxDatabaseServerCreateAndWait(
 		Delay:      checkDelay,
 		MinTimeout: minimumRefreshWait,
 	}
-	_, err = stateConf.WaitForStateContext(ctx)
+	_, err = waitForState(ctx, &stateConf)
 	if err != nil {
 		return brightboxFromErrSlice(err)
 	}
@@ -403,41 +425,32 @@ func resourceBrightboxDatabaseServerCreateAndWait(
 	return resourceBrightboxSetDatabaseServerLockState(ctx, d, meta)
 }
 
-func outputDatabaseServerOptions(opts *brightbox.DatabaseServerOptions) {
+func outputDatabaseServerOptions(ctx context.Context, opts *brightbox.DatabaseServerOptions) {
+	fields := map[string]interface{}{
+		"engine":        opts.Engine,
+		"version":       opts.Version,
+		"database_type": opts.DatabaseType,
+		"allow_access":  opts.AllowAccess,
+		"snapshot":      opts.Snapshot,
+		"zone":          opts.Zone,
+	}
 	if opts.Name != nil {
-		log.Printf("[DEBUG] Database Server Name %v", *opts.Name)
+		fields["name"] = *opts.Name
 	}
 	if opts.Description != nil {
-		log.Printf("[DEBUG] Database Server Description %v", *opts.Description)
-	}
-	if opts.Engine != "" {
-		log.Printf("[DEBUG] Database Server Engine %v", opts.Engine)
-	}
-	if opts.Version != "" {
-		log.Printf("[DEBUG] Database Server Version %v", opts.Version)
-	}
-	if opts.DatabaseType != "" {
-		log.Printf("[DEBUG] Database Server Type %v", opts.DatabaseType)
-	}
-	if opts.AllowAccess != nil {
-		log.Printf("[DEBUG] Database Server AllowAccess %#v", opts.AllowAccess)
-	}
-	if opts.Snapshot != "" {
-		log.Printf("[DEBUG] Database Server Snapshot %q", opts.Snapshot)
+		fields["description"] = *opts.Description
 	}
 	if opts.SnapshotsRetention != nil {
-		log.Printf("[DEBUG] Database Server Snapshots Retention %q", *opts.SnapshotsRetention)
+		fields["snapshots_retention"] = *opts.SnapshotsRetention
 	}
 	if opts.SnapshotsSchedule != nil {
-		log.Printf("[DEBUG] Database Server Snapshots Schedule %q", *opts.SnapshotsSchedule)
-	}
-	if opts.Zone != "" {
-		log.Printf("[DEBUG] Database Server Zone %v", opts.Zone)
+		fields["snapshots_schedule"] = *opts.SnapshotsSchedule
 	}
 	if opts.MaintenanceWeekday != nil {
-		log.Printf("[DEBUG] Database Server MaintenanceWeekday %v", *opts.MaintenanceWeekday)
+		fields["maintenance_weekday"] = *opts.MaintenanceWeekday
 	}
 	if opts.MaintenanceHour != nil {
-		log.Printf("[DEBUG] Database Server MaintenanceHour %v", *opts.MaintenanceHour)
+		fields["maintenance_hour"] = *opts.MaintenanceHour
 	}
+	tflog.Debug(ctx, "Database Server create configuration", fields)
 }
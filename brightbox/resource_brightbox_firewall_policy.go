@@ -2,9 +2,9 @@ package brightbox
 
 import (
 	"context"
-	"log"
 
 	brightbox "github.com/brightbox/gobrightbox/v2"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -18,7 +18,14 @@ func resourceBrightboxFirewallPolicy() *schema.Resource {
 		UpdateContext: resourceBrightboxFirewallPolicyUpdateAndRemap,
 		DeleteContext: resourceBrightboxFirewallPolicyDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: resourceBrightboxImport(
+				(*brightbox.Client).FirewallPolicies,
+				"Firewall Policy",
+				func(v brightbox.FirewallPolicy) string { return v.ID },
+				func(v brightbox.FirewallPolicy) string { return v.Name },
+				nil,
+				nil,
+			),
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -136,7 +143,7 @@ func resourceBrightboxFirewallPolicyCreateAndAssign(
 	if err != nil {
 		return brightboxFromErrSlice(err)
 	}
-	log.Printf("[DEBUG] setting details from returned object")
+	tflog.Debug(ctx, "Setting details from returned object")
 	return setFirewallPolicyAttributes(d, FirewallPolicyInstance)
 }
 
@@ -146,7 +153,9 @@ func assignFirewallPolicy(
 	meta interface{},
 	target string,
 ) (*brightbox.FirewallPolicy, error) {
-	log.Printf("[INFO] Attaching %s to %s", d.Id(), target)
+	tflog.Info(ctx, "Attaching Firewall Policy", map[string]interface{}{
+		logFieldTarget: target,
+	})
 	client := meta.(*CompositeClient).APIClient
 	return client.ApplyFirewallPolicy(
 		ctx,
@@ -163,17 +172,19 @@ func unassignFirewallPolicy(
 	client := meta.(*CompositeClient).APIClient
 	targetID, _ := d.GetChange("server_group")
 	if target := targetID.(string); target != "" {
-		log.Printf("[INFO] Detaching %s from %s", d.Id(), target)
+		tflog.Info(ctx, "Detaching Firewall Policy", map[string]interface{}{
+			logFieldTarget: target,
+		})
 		_, err := client.RemoveFirewallPolicy(
 			ctx,
 			d.Id(),
 			brightbox.FirewallPolicyAttachment{ServerGroup: target},
 		)
 		if err == nil {
-			log.Printf("[DEBUG] detached cleanly")
+			tflog.Debug(ctx, "Detached cleanly")
 			return nil
 		}
-		log.Printf("[DEBUG] detachment failed - checking for out of band detachment")
+		tflog.Debug(ctx, "Detachment failed - checking for out of band detachment")
 		instance, readerr := client.FirewallPolicy(ctx, d.Id())
 		if readerr != nil {
 			return diag.FromErr(readerr)
@@ -181,7 +192,7 @@ func unassignFirewallPolicy(
 		if !detachedFirewallPolicy(instance) {
 			return brightboxFromErrSlice(err)
 		}
-		log.Printf("[DEBUG] detached out of band")
+		tflog.Debug(ctx, "Detached out of band")
 	}
 	return nil
 }
@@ -198,7 +209,7 @@ func resourceBrightboxFirewallPolicyUpdateAndRemap(
 	var diags diag.Diagnostics
 
 	if d.HasChange("server_group") {
-		log.Printf("[INFO] Server Group changed, updating...")
+		tflog.Info(ctx, "Server Group changed, updating")
 		diags = append(diags, unassignFirewallPolicy(ctx, d, meta)...)
 		if targetID, ok := d.GetOk("server_group"); ok {
 			if target := targetID.(string); target != "" {
@@ -2,10 +2,10 @@ package brightbox
 
 import (
 	"context"
-	"log"
 	"regexp"
 
 	brightbox "github.com/brightbox/gobrightbox/v2"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -235,15 +235,15 @@ func resourceBrightboxFirewallRuleUpdateAndRegenerate(
 ) diag.Diagnostics {
 
 	if d.HasChange("firewall_policy") {
-		log.Printf("[INFO] Firewall Policy changed, regenerating rule")
-		log.Printf("[INFO] Removing original rule %s", d.Id())
+		tflog.Info(ctx, "Firewall Policy changed, regenerating rule")
+		tflog.Info(ctx, "Removing original rule")
 
 		errs := resourceBrightboxFirewallRuleDelete(ctx, d, meta)
 		if errs.HasError() {
 			return errs
 		}
 
-		log.Printf("[INFO] Creating new rule")
+		tflog.Info(ctx, "Creating new rule")
 		return resourceBrightboxFirewallRuleCreate(ctx, d, meta)
 	}
 	return resourceBrightboxFirewallRuleUpdate(ctx, d, meta)
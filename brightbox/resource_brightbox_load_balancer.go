@@ -4,7 +4,6 @@ import (
 	"bytes"
 	"context"
 	"fmt"
-	"log"
 	"strings"
 
 	brightbox "github.com/brightbox/gobrightbox/v2"
@@ -13,6 +12,7 @@ import (
 	"github.com/brightbox/gobrightbox/v2/enums/listenerprotocol"
 	"github.com/brightbox/gobrightbox/v2/enums/loadbalancerstatus"
 	"github.com/brightbox/gobrightbox/v2/enums/proxyprotocol"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -35,7 +35,14 @@ func resourceBrightboxLoadBalancer() *schema.Resource {
 		UpdateContext: resourceBrightboxLoadBalancerUpdate,
 		DeleteContext: resourceBrightboxLoadBalancerDeleteAndWait,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: resourceBrightboxImport(
+				(*brightbox.Client).LoadBalancers,
+				"Load Balancer",
+				func(v brightbox.LoadBalancer) string { return v.ID },
+				func(v brightbox.LoadBalancer) string { return v.Name },
+				func(v brightbox.LoadBalancer) []string { return cloudIPHostnames(v.CloudIPs) },
+				loadBalancerUnavailable,
+			),
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -62,10 +69,30 @@ func resourceBrightboxLoadBalancer() *schema.Resource {
 			},
 
 			"certificate_private_key": {
-				Description: "RSA private key used to sign the certificate in PEM format",
-				Type:        schema.TypeString,
-				Optional:    true,
-				StateFunc:   hashString,
+				Description:   "RSA private key used to sign the certificate in PEM format",
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				StateFunc:     hashString,
+				ConflictsWith: []string{"certificate_private_key_wo"},
+			},
+
+			"certificate_private_key_wo": {
+				Description:   "RSA private key used to sign the certificate in PEM format, never stored in the plan or state. Sent whenever certificate_private_key_wo_version changes",
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				WriteOnly:     true,
+				ConflictsWith: []string{"certificate_private_key"},
+				RequiredWith:  []string{"certificate_pem", "certificate_private_key_wo_version"},
+			},
+
+			"certificate_private_key_wo_version": {
+				Description:  "Change to send certificate_private_key_wo to the load balancer again",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"certificate_private_key_wo"},
+				ValidateFunc: validation.IntAtLeast(1),
 			},
 
 			"domains": {
@@ -77,7 +104,7 @@ func resourceBrightboxLoadBalancer() *schema.Resource {
 					Type:         schema.TypeString,
 					ValidateFunc: validation.StringMatch(dnsNameRegexp, "must be a valid DNS name"),
 				},
-				ConflictsWith: []string{"certificate_pem", "certificate_private_key"},
+				ConflictsWith: []string{"certificate_pem", "certificate_private_key", "certificate_private_key_wo"},
 			},
 
 			"healthcheck": {
@@ -317,6 +344,9 @@ func addUpdateableLoadBalancerOptions(
 	assignEnum(d, &opts.Policy, "policy")
 	assignString(d, &opts.CertificatePem, "certificate_pem")
 	assignString(d, &opts.CertificatePrivateKey, "certificate_private_key")
+	if diags := assignWriteOnlyString(d, &opts.CertificatePrivateKey, "certificate_private_key_wo", "certificate_private_key_wo_version"); diags.HasError() {
+		return diags
+	}
 	assignString(d, &opts.SslMinimumVersion, "ssl_minimum_version")
 	assignBool(d, &opts.HTTPSRedirect, "https_redirect")
 	if d.HasChange("domains") {
@@ -380,12 +410,10 @@ func setLoadBalancerAttributes(
 	if err != nil {
 		diags = append(diags, diag.Errorf("unexpected: %s", err)...)
 	}
-	log.Printf("[DEBUG] Healthcheck details are %+v", loadBalancer.Healthcheck)
 	err = d.Set("healthcheck", mapFromHealthcheck(&loadBalancer.Healthcheck))
 	if err != nil {
 		diags = append(diags, diag.Errorf("unexpected: %s", err)...)
 	}
-	log.Printf("[DEBUG] Certificate details are %+v", loadBalancer.Certificate)
 	return diags
 }
 
@@ -404,9 +432,16 @@ func loadBalancerStateRefresh(client *brightbox.Client, ctx context.Context, loa
 	return func() (interface{}, string, error) {
 		loadBalancer, err := client.LoadBalancer(ctx, loadBalancerID)
 		if err != nil {
-			log.Printf("Error on Load Balancer State Refresh: %s", err)
+			tflog.SubsystemError(ctx, waitSubsystem, "Error on Load Balancer State Refresh", map[string]interface{}{
+				logFieldResourceID: loadBalancerID,
+				"error":            err.Error(),
+			})
 			return nil, "", err
 		}
+		tflog.SubsystemTrace(ctx, waitSubsystem, "Load Balancer State Refresh", map[string]interface{}{
+			logFieldResourceID: loadBalancerID,
+			"status":           loadBalancer.Status.String(),
+		})
 		return loadBalancer, loadBalancer.Status.String(), nil
 	}
 }
@@ -480,7 +515,7 @@ func resourceBrightboxLoadBalancerCreateAndWait(
 ) diag.Diagnostics {
 	client := meta.(*CompositeClient).APIClient
 
-	log.Printf("[INFO]] Creating Load Balancer")
+	tflog.Info(ctx, "Creating Load Balancer")
 	var loadBalancerOpts brightbox.LoadBalancerOptions
 
 	errs := addUpdateableLoadBalancerOptions(d, &loadBalancerOpts)
@@ -488,8 +523,7 @@ func resourceBrightboxLoadBalancerCreateAndWait(
 		return errs
 	}
 
-	log.Printf("[DEBUG] Load Balancer create configuration %+v", loadBalancerOpts)
-	outputLoadBalancerOptions(&loadBalancerOpts)
+	outputLoadBalancerOptions(ctx, &loadBalancerOpts)
 
 	loadBalancer, err := client.CreateLoadBalancer(ctx, loadBalancerOpts)
 	if err != nil {
@@ -498,7 +532,9 @@ func resourceBrightboxLoadBalancerCreateAndWait(
 
 	d.SetId(loadBalancer.ID)
 
-	log.Printf("[INFO] Waiting for Load Balancer (%s) to become available", d.Id())
+	tflog.SubsystemInfo(ctx, waitSubsystem, "Waiting for Load Balancer to become available", map[string]interface{}{
+		logFieldResourceID: d.Id(),
+	})
 
 	stateConf := retry.StateChangeConf{
 		Pending: []string{
@@ -512,7 +548,7 @@ func resourceBrightboxLoadBalancerCreateAndWait(
 		Delay:      checkDelay,
 		MinTimeout: minimumRefreshWait,
 	}
-	_, err = stateConf.WaitForStateContext(ctx)
+	_, err = waitForState(ctx, &stateConf)
 	if err != nil {
 		return brightboxFromErrSlice(err)
 	}
@@ -594,29 +630,28 @@ func expandNodes(configured []interface{}) []brightbox.LoadBalancerNode {
 	return nodes
 }
 
-func outputLoadBalancerOptions(opts *brightbox.LoadBalancerOptions) {
+func outputLoadBalancerOptions(ctx context.Context, opts *brightbox.LoadBalancerOptions) {
+	fields := map[string]interface{}{
+		"listeners":               len(opts.Listeners),
+		"domains":                 opts.Domains,
+		"certificate_pem":         opts.CertificatePem != nil,
+		"certificate_private_key": opts.CertificatePrivateKey != nil,
+	}
 	if opts.Name != nil {
-		log.Printf("[DEBUG] Load Balancer Name %v", *opts.Name)
+		fields["name"] = *opts.Name
 	}
 	if opts.Nodes != nil {
-		log.Printf("[DEBUG] Load Balancer Nodes %#v", opts.Nodes)
+		nodes := make([]string, len(opts.Nodes))
+		for i, node := range opts.Nodes {
+			nodes[i] = node.Node
+		}
+		fields["nodes"] = nodes
 	}
 	if opts.Policy != 0 {
-		log.Printf("[DEBUG] Load Balancer Policy %v", opts.Policy.String())
-	}
-	if opts.Listeners != nil {
-		log.Printf("[DEBUG] Load Balancer Listeners %#v", opts.Listeners)
+		fields["policy"] = opts.Policy.String()
 	}
 	if opts.Healthcheck != nil {
-		log.Printf("[DEBUG] Load Balancer Healthcheck %#v", *opts.Healthcheck)
-	}
-	if opts.Domains != nil {
-		log.Printf("[DEBUG] Load Balancer Domains %#v", opts.Domains)
-	}
-	if opts.CertificatePem != nil {
-		log.Printf("[DEBUG] Load Balancer CertificatePem %v", *opts.CertificatePem)
-	}
-	if opts.CertificatePrivateKey != nil {
-		log.Printf("[DEBUG] Load Balancer CertificatePrivateKey %v", *opts.CertificatePrivateKey)
+		fields["healthcheck"] = opts.Healthcheck.Type.String()
 	}
+	tflog.Debug(ctx, "Load Balancer create configuration", fields)
 }
@@ -9,6 +9,7 @@ import (
 	brightbox "github.com/brightbox/gobrightbox/v2"
 	"github.com/brightbox/gobrightbox/v2/enums/balancingpolicy"
 	"github.com/brightbox/gobrightbox/v2/enums/loadbalancerstatus"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
@@ -618,7 +619,7 @@ func init() {
 			defer cancel()
 			client, errs := obtainCloudClient()
 			if errs != nil {
-				return fmt.Errorf(errs[0].Summary)
+				return fmt.Errorf("%s", errs[0].Summary)
 			}
 			objects, err := client.APIClient.LoadBalancers(ctx)
 			if err != nil {
@@ -642,3 +643,50 @@ func init() {
 		},
 	})
 }
+
+// testLoadBalancerAttributes returns the blocks a load balancer
+// configuration requires
+func testLoadBalancerAttributes(attributes map[string]cty.Value) map[string]cty.Value {
+	objectType := resourceBrightboxLoadBalancer().CoreConfigSchema().ImpliedType()
+	block := func(name string, attributes map[string]cty.Value) cty.Value {
+		blockType := objectType.AttributeType(name)
+		element := testObjectValue(blockType.ElementType(), attributes)
+		if blockType.IsSetType() {
+			return cty.SetVal([]cty.Value{element})
+		}
+		return cty.ListVal([]cty.Value{element})
+	}
+	attributes["healthcheck"] = block("healthcheck", map[string]cty.Value{
+		"type": cty.StringVal("http"),
+		"port": cty.NumberIntVal(80),
+	})
+	attributes["listener"] = block("listener", map[string]cty.Value{
+		"protocol": cty.StringVal("https"),
+		"in":       cty.NumberIntVal(443),
+		"out":      cty.NumberIntVal(80),
+	})
+	return attributes
+}
+
+func TestLoadBalancerWriteOnlyVersionZeroRejected(t *testing.T) {
+	testWriteOnlyVersionZeroRejected(t, resourceBrightboxLoadBalancer(), "certificate_private_key_wo_version", testLoadBalancerAttributes(map[string]cty.Value{
+		"certificate_pem":            cty.StringVal("certificate"),
+		"certificate_private_key_wo": cty.StringVal("secret"),
+	}))
+}
+
+func TestLoadBalancerCreateSendsWriteOnlyPrivateKey(t *testing.T) {
+	loadBalancer := resourceBrightboxLoadBalancer()
+	d := testPlannedResourceData(t, loadBalancer, testConfigValue(loadBalancer, testLoadBalancerAttributes(map[string]cty.Value{
+		"certificate_pem":                    cty.StringVal("certificate"),
+		"certificate_private_key_wo":         cty.StringVal("private key"),
+		"certificate_private_key_wo_version": cty.NumberIntVal(1),
+	})))
+	var opts brightbox.LoadBalancerOptions
+	if diags := addUpdateableLoadBalancerOptions(d, &opts); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if opts.CertificatePrivateKey == nil || *opts.CertificatePrivateKey != "private key" {
+		t.Errorf("expected the write-only private key to be sent, got %v", opts.CertificatePrivateKey)
+	}
+}
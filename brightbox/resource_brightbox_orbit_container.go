@@ -2,12 +2,12 @@ package brightbox
 
 import (
 	"context"
-	"log"
 	"net/url"
 	"strings"
 	"time"
 
 	"github.com/gophercloud/gophercloud/openstack/objectstorage/v1/containers"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -55,6 +55,7 @@ func resourceBrightboxContainer() *schema.Resource {
 				Description: "Container sync key",
 				Type:        schema.TypeString,
 				Optional:    true,
+				Sensitive:   true,
 			},
 
 			"container_sync_to": {
@@ -134,16 +135,18 @@ func resourceBrightboxContainerCreate(
 	client := meta.(*CompositeClient).OrbitClient
 	client.ProviderClient.Context = ctx
 
-	log.Printf("[INFO] Creating Container")
 	createOpts := getCreateContainerOptions(d)
-	log.Printf("[DEBUG] Container create configuration: %#v", createOpts)
 	currentContainerPath := containerPath(d)
-	log.Printf("[DEBUG] Create path is: %s", currentContainerPath)
+	tflog.SubsystemInfo(ctx, orbitSubsystem, "Creating Container", map[string]interface{}{
+		"path": currentContainerPath,
+	})
 	container, err := containers.Create(client, currentContainerPath, createOpts).Extract()
 	if err != nil {
 		return brightboxFromErrSlice(err)
 	}
-	log.Printf("[INFO] Container created with TransID %s", container.TransID)
+	tflog.SubsystemInfo(ctx, orbitSubsystem, "Container created", map[string]interface{}{
+		"trans_id": container.TransID,
+	})
 	d.SetId(currentContainerPath)
 	return resourceBrightboxContainerRead(ctx, d, meta)
 }
@@ -156,12 +159,14 @@ func resourceBrightboxContainerDelete(
 	client := meta.(*CompositeClient).OrbitClient
 	client.ProviderClient.Context = ctx
 
-	log.Printf("[INFO] Deleting Container")
+	tflog.SubsystemInfo(ctx, orbitSubsystem, "Deleting Container")
 	container, err := containers.Delete(client, d.Id()).Extract()
 	if err != nil {
 		return brightboxFromErrSlice(err)
 	}
-	log.Printf("[INFO] Container deleted with TransID %s", container.TransID)
+	tflog.SubsystemInfo(ctx, orbitSubsystem, "Container deleted", map[string]interface{}{
+		"trans_id": container.TransID,
+	})
 	return nil
 }
 
@@ -173,14 +178,15 @@ func resourceBrightboxContainerUpdate(
 	client := meta.(*CompositeClient).OrbitClient
 	client.ProviderClient.Context = ctx
 
-	log.Printf("[INFO] Updating Container")
+	tflog.SubsystemInfo(ctx, orbitSubsystem, "Updating Container")
 	updateOpts := getUpdateContainerOptions(d)
-	log.Printf("[INFO] Container update configuration: %#v", updateOpts)
 	container, err := containers.Update(client, d.Id(), updateOpts).Extract()
 	if err != nil {
 		return brightboxFromErrSlice(err)
 	}
-	log.Printf("[INFO] Container updated with TransID %s", container.TransID)
+	tflog.SubsystemInfo(ctx, orbitSubsystem, "Container updated", map[string]interface{}{
+		"trans_id": container.TransID,
+	})
 	return resourceBrightboxContainerRead(ctx, d, meta)
 }
 
@@ -192,14 +198,16 @@ func resourceBrightboxContainerRead(
 	client := meta.(*CompositeClient).OrbitClient
 	client.ProviderClient.Context = ctx
 
-	log.Printf("[DEBUG] Reading container: %s", d.Id())
+	tflog.SubsystemDebug(ctx, orbitSubsystem, "Reading Container")
 	result := containers.Get(client, d.Id(), nil)
 	getresult, err := result.Extract()
 	if err != nil {
-		log.Printf("[DEBUG] Checking if container is deleted")
+		tflog.SubsystemDebug(ctx, orbitSubsystem, "Checking if Container is deleted")
 		return diag.FromErr(CheckDeleted(d, result.Err, "container"))
 	}
-	log.Printf("[INFO] Container read with TransID %s", getresult.TransID)
+	tflog.SubsystemDebug(ctx, orbitSubsystem, "Container read", map[string]interface{}{
+		"trans_id": getresult.TransID,
+	})
 	metadata, _ := result.ExtractMetadata()
 	return setContainerAttributes(d, getresult, metadata)
 }
@@ -243,7 +251,6 @@ func setContainerAttributes(
 	metadata map[string]string,
 ) diag.Diagnostics {
 	var diags diag.Diagnostics
-	log.Printf("[DEBUG] Setting Container details from %#v", attr)
 	if err := d.Set("name", d.Id()); err != nil {
 		diags = append(diags, brightboxFromErr(err))
 	}
@@ -3,11 +3,12 @@ package brightbox
 import (
 	"context"
 	"fmt"
-	"log"
 	"time"
 
 	brightbox "github.com/brightbox/gobrightbox/v2"
 	"github.com/brightbox/gobrightbox/v2/enums/serverstatus"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -26,7 +27,7 @@ func resourceBrightboxServer() *schema.Resource {
 		UpdateContext: resourceBrightboxServerUpdate,
 		DeleteContext: resourceBrightboxServerDeleteAndWait,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: resourceBrightboxServerImport,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -36,6 +37,8 @@ func resourceBrightboxServer() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 
+			"cloud_ips": serverCloudIPsSchema(),
+
 			"data_volumes": {
 				Description: "List of volumes to attach to server",
 				Type:        schema.TypeSet,
@@ -93,6 +96,8 @@ func resourceBrightboxServer() *schema.Resource {
 				Computed:    true,
 			},
 
+			"interfaces": serverInterfacesSchema(),
+
 			"ipv4_address": {
 				Description: "Public IPv4 address of the interface",
 				Type:        schema.TypeString,
@@ -190,17 +195,48 @@ func resourceBrightboxServer() *schema.Resource {
 				Description:   "Data made available to Cloud Init",
 				Type:          schema.TypeString,
 				Optional:      true,
-				ConflictsWith: []string{"user_data_base64"},
+				Sensitive:     true,
+				ConflictsWith: []string{"user_data_base64", "user_data_wo"},
 				StateFunc:     hashString,
 				ValidateFunc:  validation.StringIsNotWhiteSpace,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					imported, _ := d.GetChange("user_data_base64")
+					return old == "" && userDataMatches(imported.(string), new)
+				},
 			},
 
 			"user_data_base64": {
 				Description:   "Base64 encoded data made available to Cloud Init",
 				Type:          schema.TypeString,
 				Optional:      true,
-				ConflictsWith: []string{"user_data"},
+				Sensitive:     true,
+				ConflictsWith: []string{"user_data", "user_data_wo"},
 				ValidateFunc:  validation.StringIsBase64,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					configured, diags := d.GetRawConfigAt(cty.GetAttrPath("user_data"))
+					return new == "" && !diags.HasError() && configured.Type() == cty.String &&
+						configured.IsKnown() && !configured.IsNull() &&
+						userDataMatches(old, hashString(configured.AsString()))
+				},
+			},
+
+			"user_data_wo": {
+				Description:   "Data made available to Cloud Init, never stored in the plan or state. Sent whenever user_data_wo_version changes",
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				WriteOnly:     true,
+				ConflictsWith: []string{"user_data", "user_data_base64"},
+				RequiredWith:  []string{"user_data_wo_version"},
+				ValidateFunc:  validation.StringIsNotWhiteSpace,
+			},
+
+			"user_data_wo_version": {
+				Description:  "Change to send user_data_wo to the server again",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"user_data_wo"},
+				ValidateFunc: validation.IntAtLeast(1),
 			},
 
 			"username": {
@@ -267,20 +303,27 @@ func addUpdateableServerOptions(
 	assignString(d, &opts.SnapshotsSchedule, "snapshots_schedule")
 	assignString(d, &opts.SnapshotsRetention, "snapshots_retention")
 	assignStringSet(d, &opts.ServerGroups, "server_groups")
-	if !d.HasChanges("user_data", "user_data_base64") {
+	if !d.HasChanges("user_data", "user_data_base64", "user_data_wo_version") {
 		return nil
 	}
 	// Definitely have user data changes that need sending
 	encodedUserData := ""
+	if d.HasChange("user_data_wo_version") {
+		userData, diags := writeOnlyString(d, "user_data_wo")
+		if diags.HasError() {
+			return diags
+		}
+		if userData != "" {
+			encodedUserData = base64Encode(userData)
+		}
+	}
 	if d.HasChange("user_data") {
 		if userData, ok := d.GetOk("user_data"); ok {
-			log.Printf("[DEBUG] UserData to encode: %s", userData.(string))
 			encodedUserData = base64Encode(userData.(string))
 		}
 	}
 	if d.HasChange("user_data_base64") {
 		if userData, ok := d.GetOk("user_data_base64"); ok {
-			log.Printf("[DEBUG] Encoded Userdata found, passing through")
 			encodedUserData = userData.(string)
 		}
 	}
@@ -295,6 +338,117 @@ func addUpdateableServerOptions(
 	return nil
 }
 
+func serverInterfacesSchema() *schema.Schema {
+	return &schema.Schema{
+		Description: "Every network interface connected to this server",
+		Type:        schema.TypeList,
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"id": {
+					Description: "The ID of the interface",
+					Type:        schema.TypeString,
+					Computed:    true,
+				},
+				"ipv4_address": {
+					Description: "Private IPv4 address of the interface",
+					Type:        schema.TypeString,
+					Computed:    true,
+				},
+				"ipv6_address": {
+					Description: "Public IPv6 address of the interface",
+					Type:        schema.TypeString,
+					Computed:    true,
+				},
+				"mac_address": {
+					Description: "MAC address of the interface",
+					Type:        schema.TypeString,
+					Computed:    true,
+				},
+			},
+		},
+	}
+}
+
+func serverCloudIPsSchema() *schema.Schema {
+	return &schema.Schema{
+		Description: "Every Cloud IP mapped to this server",
+		Type:        schema.TypeList,
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"fqdn": {
+					Description: "Fully qualified domain name of the Cloud IP",
+					Type:        schema.TypeString,
+					Computed:    true,
+				},
+				"id": {
+					Description: "The ID of the Cloud IP",
+					Type:        schema.TypeString,
+					Computed:    true,
+				},
+				"interface": {
+					Description: "The ID of the interface the Cloud IP is mapped to",
+					Type:        schema.TypeString,
+					Computed:    true,
+				},
+				"name": {
+					Description: "User label of the Cloud IP",
+					Type:        schema.TypeString,
+					Computed:    true,
+				},
+				"public_ipv4": {
+					Description: "Public IPv4 address of the Cloud IP",
+					Type:        schema.TypeString,
+					Computed:    true,
+				},
+				"public_ipv6": {
+					Description: "Public IPv6 address of the Cloud IP",
+					Type:        schema.TypeString,
+					Computed:    true,
+				},
+				"reverse_dns": {
+					Description: "Reverse DNS entry for the Cloud IP",
+					Type:        schema.TypeString,
+					Computed:    true,
+				},
+			},
+		},
+	}
+}
+
+func flattenServerInterfaces(server *brightbox.Server) []interface{} {
+	result := make([]interface{}, len(server.Interfaces))
+	for i, serverInterface := range server.Interfaces {
+		result[i] = map[string]interface{}{
+			"id":           serverInterface.ID,
+			"mac_address":  serverInterface.MacAddress,
+			"ipv4_address": serverInterface.IPv4Address,
+			"ipv6_address": serverInterface.IPv6Address,
+		}
+	}
+	return result
+}
+
+func flattenServerCloudIPs(server *brightbox.Server) []interface{} {
+	result := make([]interface{}, len(server.CloudIPs))
+	for i, cloudIP := range server.CloudIPs {
+		flattened := map[string]interface{}{
+			"id":          cloudIP.ID,
+			"name":        cloudIP.Name,
+			"public_ipv4": cloudIP.PublicIPv4,
+			"public_ipv6": cloudIP.PublicIPv6,
+			"fqdn":        cloudIP.Fqdn,
+			"reverse_dns": cloudIP.ReverseDNS,
+		}
+		if cloudIP.Interface != nil {
+			flattened["interface"] = cloudIP.Interface.ID
+		}
+		result[i] = flattened
+	}
+	return result
+}
+
 func setServerAttributes(
 	d *schema.ResourceData,
 	server *brightbox.Server,
@@ -379,9 +533,18 @@ func setServerAttributes(
 		}
 	}
 
+	err = d.Set("interfaces", flattenServerInterfaces(server))
+	if err != nil {
+		diags = append(diags, diag.Errorf("unexpected: %s", err)...)
+	}
+
 	if len(server.CloudIPs) > 0 {
 		setPrimaryCloudIP(d, &server.CloudIPs[0])
 	}
+	err = d.Set("cloud_ips", flattenServerCloudIPs(server))
+	if err != nil {
+		diags = append(diags, diag.Errorf("unexpected: %s", err)...)
+	}
 
 	bootVolumes := filter(server.Volumes, func(v brightbox.Volume) bool { return v.Boot })
 
@@ -445,9 +608,16 @@ func serverStateRefresh(client *brightbox.Client, ctx context.Context, serverID
 	return func() (interface{}, string, error) {
 		serverInstance, err := client.Server(ctx, serverID)
 		if err != nil {
-			log.Printf("Error on Server State Refresh: %s", err)
+			tflog.SubsystemError(ctx, waitSubsystem, "Error on Server State Refresh", map[string]interface{}{
+				logFieldResourceID: serverID,
+				"error":            err.Error(),
+			})
 			return nil, "", err
 		}
+		tflog.SubsystemTrace(ctx, waitSubsystem, "Server State Refresh", map[string]interface{}{
+			logFieldResourceID: serverID,
+			"status":           serverInstance.Status.String(),
+		})
 		return serverInstance, serverInstance.Status.String(), nil
 	}
 }
@@ -459,7 +629,7 @@ func resourceBrightboxServerCreateAndWait(
 ) diag.Diagnostics {
 	client := meta.(*CompositeClient).APIClient
 
-	log.Printf("[INFO] Creating Server")
+	tflog.Info(ctx, "Creating Server")
 	var serverOpts brightbox.ServerOptions
 
 	errs := addUpdateableServerOptions(d, &serverOpts)
@@ -472,7 +642,12 @@ func resourceBrightboxServerCreateAndWait(
 	assignBool(d, &serverOpts.DiskEncrypted, "disk_encrypted")
 	addBlockStorageOptions(d, &serverOpts)
 
-	log.Printf("[DEBUG] Server create configuration: %+v", serverOpts)
+	tflog.Debug(ctx, "Server create configuration", map[string]interface{}{
+		"type":           d.Get("type"),
+		"zone":           d.Get("zone"),
+		"server_groups":  serverOpts.ServerGroups,
+		"disk_encrypted": d.Get("disk_encrypted"),
+	})
 
 	server, err := client.CreateServer(ctx, serverOpts)
 	if err != nil {
@@ -481,7 +656,9 @@ func resourceBrightboxServerCreateAndWait(
 
 	d.SetId(server.ID)
 
-	log.Printf("[INFO] Waiting for Server (%s) to become available", d.Id())
+	tflog.SubsystemInfo(ctx, waitSubsystem, "Waiting for Server to become available", map[string]interface{}{
+		logFieldResourceID: d.Id(),
+	})
 
 	stateConf := retry.StateChangeConf{
 		Pending: []string{
@@ -496,7 +673,7 @@ func resourceBrightboxServerCreateAndWait(
 		Delay:      checkDelay,
 		MinTimeout: minimumRefreshWait,
 	}
-	result, err := stateConf.WaitForStateContext(ctx)
+	result, err := waitForState(ctx, &stateConf)
 	if err != nil {
 		return brightboxFromErrSlice(err)
 	}
@@ -513,7 +690,7 @@ func resourceBrightboxServerUpdate(
 ) diag.Diagnostics {
 	client := meta.(*CompositeClient).APIClient
 
-	log.Printf("[DEBUG] Server update called for %s", d.Id())
+	tflog.Debug(ctx, "Server update called")
 	serverOpts := brightbox.ServerOptions{
 		ID: d.Id(),
 	}
@@ -521,12 +698,15 @@ func resourceBrightboxServerUpdate(
 	var err error
 	var diags diag.Diagnostics
 
-	if d.HasChanges("name", "server_groups", "user_data", "user_data_base64", "snapshots_retention", "snapshots_schedule") {
+	if d.HasChanges("name", "server_groups", "user_data", "user_data_base64", "user_data_wo_version", "snapshots_retention", "snapshots_schedule") {
 		diags = append(diags, addUpdateableServerOptions(d, &serverOpts)...)
 		if diags.HasError() {
 			return diags
 		}
-		log.Printf("[DEBUG] Server update configuration: %+v", serverOpts)
+		tflog.Debug(ctx, "Updating Server", map[string]interface{}{
+			"server_groups": serverOpts.ServerGroups,
+			"user_data":     serverOpts.UserData != nil,
+		})
 
 		server, err = client.UpdateServer(ctx, serverOpts)
 		if err != nil {
@@ -547,7 +727,9 @@ func resourceBrightboxServerUpdate(
 	}
 	if d.HasChange("type") {
 		newServerType := d.Get("type").(string)
-		log.Printf("[INFO] Changing server type to %v", newServerType)
+		tflog.Info(ctx, "Changing server type", map[string]interface{}{
+			"type": newServerType,
+		})
 		server, err = client.ResizeServer(
 			ctx,
 			d.Id(),
@@ -592,10 +774,41 @@ func addBlockStorageOptions(
 	opts.Image = &image
 }
 
+// importedUserData marks an imported server as having no prior record
+// of how its user data was configured, so the read that follows keeps
+// the data itself in user_data_base64 rather than its hash
+const importedUserData = "imported"
+
+func resourceBrightboxServerImport(
+	ctx context.Context,
+	d *schema.ResourceData,
+	meta interface{},
+) ([]*schema.ResourceData, error) {
+	imported, err := resourceBrightboxImport(
+		(*brightbox.Client).Servers,
+		"Server",
+		func(v brightbox.Server) string { return v.ID },
+		func(v brightbox.Server) string { return v.Name },
+		serverHostnames,
+		serverUnavailable,
+	)(ctx, d, meta)
+	if err != nil {
+		return nil, err
+	}
+	if err := d.Set("user_data_base64", importedUserData); err != nil {
+		return nil, err
+	}
+	return imported, nil
+}
+
 func setUserDataDetails(d *schema.ResourceData, base64Userdata string) diag.Diagnostics {
-	_, b64 := d.GetOk("user_data_base64")
-	if b64 {
-		log.Printf("[DEBUG] encoded user_data requested, setting user_data_base64")
+	// Write-only user data is never recorded
+	if _, ok := d.GetOk("user_data_wo_version"); ok {
+		return nil
+	}
+	// The server data source has no user_data_base64, so always records
+	// the hash
+	if _, ok := d.GetOk("user_data_base64"); ok {
 		if err := d.Set("user_data_base64", base64Userdata); err != nil {
 			return brightboxFromErrSlice(err)
 		}
@@ -610,7 +823,6 @@ func setUserDataDetails(d *schema.ResourceData, base64Userdata string) diag.Diag
 			}
 			hash = userDataHashSum(userData)
 		}
-		log.Printf("[DEBUG] decrypted user_data requested, setting user_data to %q", hash)
 		if err := d.Set("user_data", hash); err != nil {
 			return brightboxFromErrSlice(err)
 		}
@@ -618,6 +830,20 @@ func setUserDataDetails(d *schema.ResourceData, base64Userdata string) diag.Diag
 	return nil
 }
 
+// userDataMatches reports whether the user data hash matches the
+// encoded user data held in state, so that user data imported into
+// user_data_base64 can be configured with user_data instead
+func userDataMatches(encoded string, hash string) bool {
+	if encoded == "" || hash == "" {
+		return false
+	}
+	if hash == userDataHashSum(encoded) {
+		return true
+	}
+	decoded, err := base64Decode(encoded)
+	return err == nil && hash == userDataHashSum(decoded)
+}
+
 func setConnectionDetails(d *schema.ResourceData) {
 	var preferredSSHAddress string
 	if attr, ok := d.GetOk("public_hostname"); ok {
@@ -654,3 +880,12 @@ func setServerTypeDetails(d *schema.ResourceData, serverType *brightbox.ServerTy
 	}
 	return nil
 }
+
+// serverHostnames returns the public hostnames of a server, including
+// those of its mapped Cloud IPs
+func serverHostnames(server brightbox.Server) []string {
+	return append(
+		[]string{server.Fqdn, "ipv6." + server.Fqdn},
+		cloudIPHostnames(server.CloudIPs)...,
+	)
+}
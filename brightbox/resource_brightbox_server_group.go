@@ -3,10 +3,10 @@ package brightbox
 import (
 	"context"
 	"fmt"
-	"log"
 	"time"
 
 	brightbox "github.com/brightbox/gobrightbox/v2"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -20,7 +20,14 @@ func resourceBrightboxServerGroup() *schema.Resource {
 		UpdateContext: resourceBrightboxServerGroupUpdate,
 		DeleteContext: resourceBrightboxServerGroupClearAndDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: resourceBrightboxImport(
+				(*brightbox.Client).ServerGroups,
+				"Server Group",
+				func(v brightbox.ServerGroup) string { return v.ID },
+				func(v brightbox.ServerGroup) string { return v.Name },
+				nil,
+				nil,
+			),
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -161,7 +168,10 @@ func clearServerList(ctx context.Context, client *brightbox.Client, iniitialServ
 	serverID := iniitialServerGroup.ID
 	serverList := iniitialServerGroup.Servers
 	serverIds := serverGroupMemberListFromNodes(serverList)
-	log.Printf("[INFO] Removing servers %v from server group %s", serverIds, serverID)
+	tflog.Info(ctx, "Removing servers from server group", map[string]interface{}{
+		logFieldResourceID: serverID,
+		"servers":          serverIDListFromNodes(serverList),
+	})
 	_, err := client.RemoveServersFromServerGroup(ctx, serverID, serverIds)
 	if err != nil {
 		return fmt.Errorf("Error removing servers from server group %s", serverID)
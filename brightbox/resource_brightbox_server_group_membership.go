@@ -4,10 +4,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"log"
+	"sort"
 	"strings"
 
 	brightbox "github.com/brightbox/gobrightbox/v2"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -27,6 +28,7 @@ func resourceBrightboxServerGroupMembership() *schema.Resource {
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
 			Delete: schema.DefaultTimeout(defaultTimeout),
 		},
 
@@ -64,14 +66,19 @@ func resourceBrightboxServerGroupMembershipRead(
 	setter := setServerGroupMembershipAttributes
 	client := meta.(*CompositeClient).APIClient
 
-	log.Printf("[DEBUG] %s resource read called for %s", objectName, target)
+	ctx = tflog.SetField(ctx, logFieldObjectName, objectName)
+	tflog.Debug(ctx, "Resource read called", map[string]interface{}{
+		logFieldTarget: target,
+	})
 
 	object, err := reader(client, ctx, target)
 	if err != nil {
 		var apierror *brightbox.APIError
 		if !d.IsNewResource() && errors.As(err, &apierror) {
 			if apierror.StatusCode == 404 {
-				log.Printf("[WARN] %s not found, removing from state: %s", objectName, target)
+				tflog.Warn(ctx, "Object not found, removing from state", map[string]interface{}{
+					logFieldTarget: target,
+				})
 				d.SetId("")
 				return nil
 			}
@@ -79,7 +86,7 @@ func resourceBrightboxServerGroupMembershipRead(
 		return brightboxFromErrSlice(err)
 	}
 
-	log.Printf("[DEBUG] setting details from returned object: %+v", *object)
+	tflog.Debug(ctx, "Setting details from returned object")
 	return setter(d, object)
 }
 
@@ -93,9 +100,11 @@ func resourceBrightboxServerGroupMembershipCreate(
 	group := d.Get("group").(string)
 	serverList := sliceFromStringSet(d, "servers")
 
-	object, err := client.AddServersToServerGroup(ctx, group, mapServerGroupMemberList(serverList))
+	object, err := retryOnConflict(ctx, d.Timeout(schema.TimeoutCreate), func() (*brightbox.ServerGroup, error) {
+		return client.AddServersToServerGroup(ctx, group, mapServerGroupMemberList(serverList))
+	})
 	if err != nil {
-		diags = append(diags, brightboxFromErr(err))
+		return append(diags, brightboxFromErr(err))
 	}
 	d.SetId(id.UniqueId())
 	return append(diags, setServerGroupMembershipAttributes(d, object)...)
@@ -139,7 +148,7 @@ func resourceBrightboxServerGroupMembershipUpdate(
 	var diags diag.Diagnostics
 	client := meta.(*CompositeClient).APIClient
 	if d.HasChange("servers") {
-		log.Printf("[DEBUG] ServerGroupMembership change detected, updating")
+		tflog.Debug(ctx, "ServerGroupMembership change detected, updating")
 		group := d.Get("group").(string)
 
 		o, n := d.GetChange("servers")
@@ -153,9 +162,11 @@ func resourceBrightboxServerGroupMembershipUpdate(
 		os := o.(*schema.Set)
 		ns := n.(*schema.Set)
 		remove := expandStringValueList(os.Difference(ns).List())
-		log.Printf("[DEBUG] removing servers: %+v", remove)
 		add := expandStringValueList(ns.Difference(os).List())
-		log.Printf("[DEBUG] adding servers: %+v", add)
+		tflog.Debug(ctx, "Changing server group members", map[string]interface{}{
+			"removing": remove,
+			"adding":   add,
+		})
 
 		var object *brightbox.ServerGroup
 		var err error
@@ -166,11 +177,16 @@ func resourceBrightboxServerGroupMembershipUpdate(
 			}
 		}
 		if len(add) > 0 {
-			object, err = client.AddServersToServerGroup(ctx, group, mapServerGroupMemberList(add))
+			object, err = retryOnConflict(ctx, d.Timeout(schema.TimeoutUpdate), func() (*brightbox.ServerGroup, error) {
+				return client.AddServersToServerGroup(ctx, group, mapServerGroupMemberList(add))
+			})
 			if err != nil {
 				diags = append(diags, brightboxFromErr(err))
 			}
 		}
+		if diags.HasError() {
+			return diags
+		}
 		return append(diags, setServerGroupMembershipAttributes(d, object)...)
 	}
 	return resourceBrightboxServerGroupMembershipRead(ctx, d, meta)
@@ -193,6 +209,57 @@ func resourceBrightboxServerGroupMembershipDelete(
 	return diags
 }
 
+// serverGroupMembershipIdentity identifies a membership by its group
+// and servers. Adding or removing servers changes the identity.
+var serverGroupMembershipIdentity = resourceIdentity{
+	schemaFunc: func() map[string]*schema.Schema {
+		return map[string]*schema.Schema{
+			accountAttribute: {
+				Description:       "The account the server group belongs to. Defaults to the provider account",
+				Type:              schema.TypeString,
+				OptionalForImport: true,
+			},
+			"group": {
+				Description:       "Server Group ID",
+				Type:              schema.TypeString,
+				RequiredForImport: true,
+			},
+			"servers": {
+				Description:       "IDs of the member servers",
+				Type:              schema.TypeList,
+				RequiredForImport: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		}
+	},
+	set: func(d *schema.ResourceData, identity *schema.IdentityData, account string) error {
+		if err := identity.Set(accountAttribute, account); err != nil {
+			return err
+		}
+		if err := identity.Set("group", d.Get("group")); err != nil {
+			return err
+		}
+		servers := sliceFromStringSet(d, "servers")
+		sort.Strings(servers)
+		return identity.Set("servers", servers)
+	},
+	importID: func(identity *schema.IdentityData) (string, error) {
+		group, _ := identity.Get("group").(string)
+		servers, _ := identity.Get("servers").([]interface{})
+		if group == "" || len(servers) == 0 {
+			return "", fmt.Errorf("expected identity to contain a group and at least one server")
+		}
+		parts := []string{group}
+		for _, server := range servers {
+			parts = append(parts, server.(string))
+		}
+		return strings.Join(parts, "/"), nil
+	},
+	mutable: true,
+}
+
 func resourceBrightboxServerGroupMembershipImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	idParts := strings.Split(d.Id(), "/")
 	if len(idParts) < 2 {
@@ -5,12 +5,15 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"net/http"
 	"testing"
 
 	brightbox "github.com/brightbox/gobrightbox/v2"
 	"github.com/brightbox/gobrightbox/v2/enums/serverstatus"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
@@ -66,10 +69,11 @@ func TestAccBrightboxServer_Basic(t *testing.T) {
 				),
 			},
 			{
-				ResourceName:            resourceName,
-				ImportState:             true,
-				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"type"},
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// Import records the user data itself rather than its hash
+				ImportStateVerifyIgnore: []string{"type", "user_data", "user_data_base64"},
 			},
 			{
 				Config: testAccCheckBrightboxServerConfig_locked(rInt),
@@ -150,6 +154,69 @@ func TestAccBrightboxServer_userDataBase64(t *testing.T) {
 	})
 }
 
+func TestAccBrightboxServer_userDataWriteOnly(t *testing.T) {
+	resourceName := "brightbox_server.foobar"
+	var server brightbox.Server
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders(),
+		CheckDestroy:      testAccCheckBrightboxServerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckBrightboxServerConfig_write_only_userdata(rInt, "hello world", 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBrightboxObjectExists(
+						resourceName,
+						"Server",
+						&server,
+						(*brightbox.Client).Server,
+					),
+					testAccCheckBrightboxServerUserData(&server, "hello world"),
+					resource.TestCheckNoResourceAttr(resourceName, "user_data_wo"),
+					resource.TestCheckResourceAttr(resourceName, "user_data", ""),
+					resource.TestCheckResourceAttr(resourceName, "user_data_wo_version", "1"),
+				),
+			},
+			{
+				Config: testAccCheckBrightboxServerConfig_write_only_userdata(rInt, "goodbye world", 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBrightboxObjectExists(
+						resourceName,
+						"Server",
+						&server,
+						(*brightbox.Client).Server,
+					),
+					testAccCheckBrightboxServerUserData(&server, "hello world"),
+				),
+			},
+			{
+				Config: testAccCheckBrightboxServerConfig_write_only_userdata(rInt, "goodbye world", 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBrightboxObjectExists(
+						resourceName,
+						"Server",
+						&server,
+						(*brightbox.Client).Server,
+					),
+					testAccCheckBrightboxServerUserData(&server, "goodbye world"),
+					resource.TestCheckNoResourceAttr(resourceName, "user_data_wo"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckBrightboxServerUserData(server *brightbox.Server, expected string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if server.UserData != base64Encode(expected) {
+			return fmt.Errorf("expected user data %q, got %q", base64Encode(expected), server.UserData)
+		}
+		return nil
+	}
+}
+
 func TestAccBrightboxServer_serverGroup(t *testing.T) {
 	serverResourceName := "brightbox_server.foobar"
 	resourceName := "brightbox_server_group.barfoo"
@@ -878,6 +945,21 @@ data "brightbox_server_group" "barfoo" {
 		TestAccBrightboxDataServerGroupConfig_default)
 }
 
+func testAccCheckBrightboxServerConfig_write_only_userdata(rInt int, userData string, version int) string {
+	return fmt.Sprintf(`
+resource "brightbox_server" "foobar" {
+	image = data.brightbox_image.foobar.id
+	name = "foo-%d"
+	type = "1gb.ssd"
+	server_groups = [data.brightbox_server_group.default.id]
+	user_data_wo = %q
+	user_data_wo_version = %d
+}
+
+%s%s`, rInt, userData, version, TestAccBrightboxImageDataSourceConfig_blank_disk,
+		TestAccBrightboxDataServerGroupConfig_default)
+}
+
 func testAccCheckBrightboxServerConfig_userdata_update(rInt int) string {
 	return fmt.Sprintf(`
 resource "brightbox_server" "foobar" {
@@ -1057,7 +1139,7 @@ func init() {
 			defer cancel()
 			client, errs := obtainCloudClient()
 			if errs != nil {
-				return fmt.Errorf(errs[0].Summary)
+				return fmt.Errorf("%s", errs[0].Summary)
 			}
 			objects, err := client.APIClient.Servers(ctx)
 			if err != nil {
@@ -1089,3 +1171,143 @@ func init() {
 		},
 	})
 }
+
+func TestServerImportUserData(t *testing.T) {
+	encoded := base64Encode("#!/bin/sh\necho hello\n")
+	client := newFakeAPIClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id":"srv-aaaaa","name":"web","status":"active","hostname":"srv-aaaaa",
+			"user_data":"` + encoded + `",
+			"image":{"id":"img-aaaaa","username":"ubuntu"},
+			"server_type":{"id":"typ-aaaaa","handle":"1gb.ssd","disk_size":30720},
+			"zone":{"id":"zon-aaaaa","handle":"gb1-a"}}`))
+	})
+	meta := &CompositeClient{APIClient: client, Account: "acc-12345"}
+	server := Provider().ResourcesMap["brightbox_server"]
+	d, diags := importObject(context.Background(), server, meta, "srv-aaaaa", true)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if d.Get("user_data_base64") != encoded || d.Get("user_data") != "" {
+		t.Fatalf("expected the user data in user_data_base64, got %q and %q",
+			d.Get("user_data_base64"), d.Get("user_data"))
+	}
+	testCases := []struct {
+		attribute string
+		value     string
+		changed   bool
+	}{
+		{"user_data", "#!/bin/sh\necho hello\n", false},
+		{"user_data", encoded, false},
+		{"user_data_base64", encoded, false},
+		{"user_data", "#!/bin/sh\necho goodbye\n", true},
+		{"user_data_base64", base64Encode("#!/bin/sh\necho goodbye\n"), true},
+	}
+	for _, tcase := range testCases {
+		configVal := testConfigValue(server, map[string]cty.Value{
+			"image":         cty.StringVal("img-aaaaa"),
+			tcase.attribute: cty.StringVal(tcase.value),
+		})
+		config := terraform.NewResourceConfigShimmed(configVal, server.CoreConfigSchema())
+		// Planning records the raw configuration in the prior state
+		state := d.State()
+		state.RawConfig = configVal
+		diff, err := server.Diff(context.Background(), state, config, meta)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", tcase.value, err)
+			continue
+		}
+		changed := false
+		if diff != nil {
+			_, changed = diff.Attributes["user_data"]
+			if _, ok := diff.Attributes["user_data_base64"]; ok {
+				changed = true
+			}
+		}
+		if changed != tcase.changed {
+			t.Errorf("%s = %q: expected changed %t, got diff %v", tcase.attribute, tcase.value, tcase.changed, diff)
+		}
+	}
+}
+
+// testConfigValue returns a configuration of resource with the given
+// attributes set and every other attribute null
+func testConfigValue(resource *schema.Resource, attributes map[string]cty.Value) cty.Value {
+	return testObjectValue(resource.CoreConfigSchema().ImpliedType(), attributes)
+}
+
+// testObjectValue returns an object of type objectType with the given
+// attributes set and every other attribute null
+func testObjectValue(objectType cty.Type, attributes map[string]cty.Value) cty.Value {
+	values := make(map[string]cty.Value)
+	for name, attributeType := range objectType.AttributeTypes() {
+		values[name] = cty.NullVal(attributeType)
+	}
+	for name, value := range attributes {
+		values[name] = value
+	}
+	return cty.ObjectVal(values)
+}
+
+// testPlannedResourceData returns the data a create of resource is
+// given for the configuration, failing if the configuration is invalid
+func testPlannedResourceData(t *testing.T, resource *schema.Resource, configVal cty.Value) *schema.ResourceData {
+	t.Helper()
+	config := terraform.NewResourceConfigShimmed(configVal, resource.CoreConfigSchema())
+	if diags := resource.Validate(config); diags.HasError() {
+		t.Fatalf("invalid configuration: %v", diags)
+	}
+	diff, err := resource.Diff(context.Background(), nil, config, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	// Planning records the raw configuration in the diff
+	diff.RawConfig = configVal
+	d, err := schema.InternalMap(resource.SchemaMap()).Data(nil, diff)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	return d
+}
+
+// testWriteOnlyVersionZeroRejected checks that a configuration of
+// resource with attributes is rejected with version 0 alone, as a
+// version of 0 reads as unset and would skip sending the value, and
+// accepted with version 1
+func testWriteOnlyVersionZeroRejected(t *testing.T, resource *schema.Resource, version string, attributes map[string]cty.Value) {
+	t.Helper()
+	attributes[version] = cty.NumberIntVal(0)
+	config := terraform.NewResourceConfigShimmed(testConfigValue(resource, attributes), resource.CoreConfigSchema())
+	diags := resource.Validate(config)
+	if len(diags) != 1 || !diags[0].AttributePath.Equals(cty.GetAttrPath(version)) {
+		t.Errorf("%s: expected version 0 alone to be rejected, got %v", version, diags)
+	}
+	attributes[version] = cty.NumberIntVal(1)
+	config = terraform.NewResourceConfigShimmed(testConfigValue(resource, attributes), resource.CoreConfigSchema())
+	if diags := resource.Validate(config); diags.HasError() {
+		t.Errorf("%s: expected version 1 to be accepted, got %v", version, diags)
+	}
+}
+
+func TestServerWriteOnlyVersionZeroRejected(t *testing.T) {
+	testWriteOnlyVersionZeroRejected(t, resourceBrightboxServer(), "user_data_wo_version", map[string]cty.Value{
+		"image":        cty.StringVal("img-aaaaa"),
+		"user_data_wo": cty.StringVal("secret"),
+	})
+}
+
+func TestServerCreateSendsWriteOnlyUserData(t *testing.T) {
+	server := resourceBrightboxServer()
+	d := testPlannedResourceData(t, server, testConfigValue(server, map[string]cty.Value{
+		"image":                cty.StringVal("img-aaaaa"),
+		"user_data_wo":         cty.StringVal("#!/bin/sh\necho hello\n"),
+		"user_data_wo_version": cty.NumberIntVal(1),
+	}))
+	var opts brightbox.ServerOptions
+	if diags := addUpdateableServerOptions(d, &opts); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if opts.UserData == nil || *opts.UserData != base64Encode("#!/bin/sh\necho hello\n") {
+		t.Errorf("expected the write-only user data to be sent, got %v", opts.UserData)
+	}
+}
@@ -2,13 +2,13 @@ package brightbox
 
 import (
 	"context"
-	"log"
 	"regexp"
 	"time"
 
 	brightbox "github.com/brightbox/gobrightbox/v2"
 	"github.com/brightbox/gobrightbox/v2/enums/filesystemtype"
 	"github.com/brightbox/gobrightbox/v2/enums/volumestatus"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -23,7 +23,14 @@ func resourceBrightboxVolume() *schema.Resource {
 		UpdateContext: resourceBrightboxVolumeUpdateAndResize,
 		DeleteContext: resourceBrightboxVolumeDetachAndDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: resourceBrightboxImport(
+				(*brightbox.Client).Volumes,
+				"Volume",
+				func(v brightbox.Volume) string { return v.ID },
+				func(v brightbox.Volume) string { return v.Name },
+				nil,
+				volumeUnavailable,
+			),
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -184,7 +191,9 @@ func resourceBrightboxVolumeDetachAndDelete(
 ) diag.Diagnostics {
 	err := detachVolume(ctx, d, meta, d.Timeout(schema.TimeoutUpdate))
 	if err != nil {
-		log.Printf("[INFO] Detach on delete issue: %s", err)
+		tflog.Info(ctx, "Detach on delete issue", map[string]interface{}{
+			"error": err.Error(),
+		})
 	}
 	return resourceBrightboxVolumeDelete(ctx, d, meta)
 }
@@ -302,9 +311,16 @@ func volumeStateRefresh(client *brightbox.Client, ctx context.Context, volumeID
 	return func() (interface{}, string, error) {
 		volume, err := client.Volume(ctx, volumeID)
 		if err != nil {
-			log.Printf("Error on Volume State Refresh: %s", err)
+			tflog.SubsystemError(ctx, waitSubsystem, "Error on Volume State Refresh", map[string]interface{}{
+				logFieldResourceID: volumeID,
+				"error":            err.Error(),
+			})
 			return nil, "", err
 		}
+		tflog.SubsystemTrace(ctx, waitSubsystem, "Volume State Refresh", map[string]interface{}{
+			logFieldResourceID: volumeID,
+			"status":           volume.Status.String(),
+		})
 		return volume, volume.Status.String(), nil
 	}
 }
@@ -316,7 +332,7 @@ func resourceBrightboxVolumeCreateAndWait(
 ) diag.Diagnostics {
 	client := meta.(*CompositeClient).APIClient
 
-	log.Printf("[INFO] Creating volume")
+	tflog.Info(ctx, "Creating Volume")
 	var volumeOpts brightbox.VolumeOptions
 
 	var diags diag.Diagnostics
@@ -326,7 +342,11 @@ func resourceBrightboxVolumeCreateAndWait(
 		return diags
 	}
 
-	log.Printf("[DEBUG] volume create configuration: %+v", volumeOpts)
+	tflog.Debug(ctx, "Volume create configuration", map[string]interface{}{
+		"size":       d.Get("size"),
+		"image":      d.Get("image"),
+		"filesystem": d.Get("filesystem_type"),
+	})
 
 	object, err := client.CreateVolume(ctx, volumeOpts)
 	if err != nil {
@@ -335,7 +355,9 @@ func resourceBrightboxVolumeCreateAndWait(
 
 	d.SetId(object.ID)
 
-	log.Printf("[INFO] Waiting for Volume (%s) to become available", d.Id())
+	tflog.SubsystemInfo(ctx, waitSubsystem, "Waiting for Volume to become available", map[string]interface{}{
+		logFieldResourceID: d.Id(),
+	})
 
 	stateConf := retry.StateChangeConf{
 		Pending: []string{
@@ -349,7 +371,7 @@ func resourceBrightboxVolumeCreateAndWait(
 		Delay:      checkDelay,
 		MinTimeout: minimumRefreshWait,
 	}
-	_, err = stateConf.WaitForStateContext(ctx)
+	_, err = waitForState(ctx, &stateConf)
 	if err != nil {
 		return brightboxFromErrSlice(err)
 	}
@@ -371,13 +393,11 @@ func resourceBrightboxVolumeUpdateAndResize(
 	meta interface{},
 ) diag.Diagnostics {
 	var diags diag.Diagnostics
-	log.Printf("[DEBUG] Checking if volume size has changed")
 	if d.HasChange("size") {
 		diags = append(diags, resizeBrightboxVolume(ctx, d, meta, d.Id(), "size")...)
 	}
-	log.Printf("[DEBUG] Checking if server attachment has changed")
 	if d.HasChange("server") {
-		log.Printf("[INFO] Volume server attachment has changed, updating...")
+		tflog.Info(ctx, "Volume server attachment has changed, updating")
 		err := detachVolume(ctx, d, meta, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			diags = append(diags, brightboxFromErr(err))
@@ -390,7 +410,7 @@ func resourceBrightboxVolumeUpdateAndResize(
 			}
 		}
 	}
-	log.Printf("[DEBUG] Updating volume")
+	tflog.Debug(ctx, "Updating Volume")
 	return append(diags, resourceBrightboxVolumeUpdate(ctx, d, meta)...)
 }
 
@@ -401,16 +421,28 @@ func attachVolume(
 	server string,
 	timeout time.Duration,
 ) error {
-	log.Printf("[DEBUG] attaching %v to %v", d.Id(), server)
+	tflog.Debug(ctx, "Attaching Volume", map[string]interface{}{
+		logFieldTarget: server,
+	})
 	client := meta.(*CompositeClient).APIClient
-	_, err := client.AttachVolume(
-		ctx,
-		d.Id(),
-		brightbox.VolumeAttachment{Server: server},
-	)
+	start := time.Now()
+	_, err := retryOnConflict(ctx, timeout, func() (*brightbox.Volume, error) {
+		return client.AttachVolume(
+			ctx,
+			d.Id(),
+			brightbox.VolumeAttachment{Server: server},
+		)
+	})
 	if err != nil {
 		return err
 	}
+	remaining := timeout - time.Since(start)
+	if remaining <= 0 {
+		return &retry.TimeoutError{
+			Timeout:       timeout,
+			ExpectedState: []string{volumestatus.Attached.String()},
+		}
+	}
 	stateConf := retry.StateChangeConf{
 		Pending: []string{
 			volumestatus.Detached.String(),
@@ -419,11 +451,11 @@ func attachVolume(
 			volumestatus.Attached.String(),
 		},
 		Refresh:    volumeStateRefresh(client, ctx, d.Id()),
-		Timeout:    timeout,
+		Timeout:    remaining,
 		Delay:      checkDelay,
 		MinTimeout: minimumRefreshWait,
 	}
-	_, err = stateConf.WaitForStateContext(ctx)
+	_, err = waitForState(ctx, &stateConf)
 	return err
 }
 
@@ -433,7 +465,7 @@ func detachVolume(
 	meta interface{},
 	timeout time.Duration,
 ) error {
-	log.Printf("[DEBUG] detaching %v", d.Id())
+	tflog.Debug(ctx, "Detaching Volume")
 	client := meta.(*CompositeClient).APIClient
 	_, err := client.DetachVolume(
 		ctx,
@@ -454,7 +486,7 @@ func detachVolume(
 		Delay:      checkDelay,
 		MinTimeout: minimumRefreshWait,
 	}
-	_, err = stateConf.WaitForStateContext(ctx)
+	_, err = waitForState(ctx, &stateConf)
 	return err
 }
 
@@ -468,7 +500,6 @@ func resizeBrightboxVolume(
 	client := meta.(*CompositeClient).APIClient
 	var diags diag.Diagnostics
 
-	log.Printf("[DEBUG] Checking size change parameters")
 	oldSize, newSize := d.GetChange(sizeAttr)
 	oldSizeInt, ok := oldSize.(int)
 	if !ok {
@@ -485,7 +516,11 @@ func resizeBrightboxVolume(
 	if diags.HasError() {
 		return diags
 	}
-	log.Printf("[INFO] Resizing volume %v from %v to %v", volumeID, oldSizeInt, newSizeInt)
+	tflog.Info(ctx, "Resizing Volume", map[string]interface{}{
+		logFieldResourceID: volumeID,
+		"from":             oldSizeInt,
+		"to":               newSizeInt,
+	})
 	_, err := client.ResizeVolume(
 		ctx,
 		volumeID,
@@ -0,0 +1,77 @@
+package brightbox
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	brightbox "github.com/brightbox/gobrightbox/v2"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+// Fragments of the error messages the API returns when an object is
+// too busy to accept a change, alongside an Unprocessable Entity status.
+// An invalid state on its own is usually permanent, so is not retried.
+var busyErrorMarkers = []string{
+	"busy",
+	"in progress",
+	"not ready",
+	"try again",
+}
+
+// isConflictError reports whether an error is the API refusing a
+// change because the object, or one it depends upon, is busy. These
+// errors clear once the object settles, so the change can be retried.
+func isConflictError(err error) bool {
+	var apiError *brightbox.APIError
+	if !errors.As(err, &apiError) {
+		return false
+	}
+	switch apiError.StatusCode {
+	case http.StatusConflict:
+		return true
+	case http.StatusUnprocessableEntity:
+		text := strings.ToLower(apiError.ErrorName + " " + strings.Join(apiError.Errors, " "))
+		for _, marker := range busyErrorMarkers {
+			if strings.Contains(text, marker) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// retryOnConflict calls the operation until it succeeds, fails for a
+// reason other than a conflict, or the timeout expires, backing off
+// between attempts
+func retryOnConflict[T any](
+	ctx context.Context,
+	timeout time.Duration,
+	operation func() (T, error),
+) (T, error) {
+	var mutex sync.Mutex
+	var result T
+	err := retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+		object, err := operation()
+		if err == nil {
+			mutex.Lock()
+			defer mutex.Unlock()
+			result = object
+			return nil
+		}
+		if isConflictError(err) {
+			tflog.Debug(ctx, "Object busy, retrying", map[string]interface{}{
+				"error": err.Error(),
+			})
+			return retry.RetryableError(err)
+		}
+		return retry.NonRetryableError(err)
+	})
+	mutex.Lock()
+	defer mutex.Unlock()
+	return result, err
+}
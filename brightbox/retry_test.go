@@ -0,0 +1,192 @@
+package brightbox
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	brightbox "github.com/brightbox/gobrightbox/v2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	conflictResponse = `{"error_name":"conflict","errors":["Server is busy"]}`
+	busyResponse     = `{"error_name":"invalid_state","errors":["Server is not ready, try again later"]}`
+	invalidResponse  = `{"error_name":"invalid_record","errors":["Destination is invalid"]}`
+)
+
+func TestIsConflictError(t *testing.T) {
+	testCases := []struct {
+		err       error
+		retryable bool
+	}{
+		{&brightbox.APIError{StatusCode: http.StatusConflict}, true},
+		{&brightbox.APIError{StatusCode: http.StatusUnprocessableEntity, ErrorName: "invalid_state"}, false},
+		{&brightbox.APIError{StatusCode: http.StatusUnprocessableEntity, ErrorName: "invalid_state", Errors: []string{"Volume is already attached"}}, false},
+		{&brightbox.APIError{StatusCode: http.StatusUnprocessableEntity, ErrorName: "invalid_state", Errors: []string{"Server is not ready"}}, true},
+		{&brightbox.APIError{StatusCode: http.StatusUnprocessableEntity, Errors: []string{"Server is busy"}}, true},
+		{&brightbox.APIError{StatusCode: http.StatusUnprocessableEntity, Errors: []string{"Name can't be blank"}}, false},
+		{&brightbox.APIError{StatusCode: http.StatusNotFound}, false},
+		{&brightbox.APIError{StatusCode: http.StatusInternalServerError, Errors: []string{"busy"}}, false},
+		{errors.New("busy"), false},
+	}
+	for _, tcase := range testCases {
+		if result := isConflictError(tcase.err); result != tcase.retryable {
+			t.Errorf("%v: expected %v, got %v", tcase.err, tcase.retryable, result)
+		}
+	}
+}
+
+// failingHandler answers with each failure in turn before answering
+// with success, counting the requests made
+func failingHandler(calls *int32, failures []int, bodies []string, success string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		call := int(atomic.AddInt32(calls, 1)) - 1
+		w.Header().Set("Content-Type", "application/json")
+		if call < len(failures) {
+			w.WriteHeader(failures[call])
+			w.Write([]byte(bodies[call]))
+			return
+		}
+		w.WriteHeader(http.StatusAccepted)
+		w.Write([]byte(success))
+	}
+}
+
+func TestAssignCloudIPRetriesConflicts(t *testing.T) {
+	var calls int32
+	mapCloudIP := failingHandler(
+		&calls,
+		[]int{http.StatusConflict, http.StatusUnprocessableEntity},
+		[]string{conflictResponse, busyResponse},
+		`{"id":"cip-12345","status":"unmapped"}`,
+	)
+	client := newFakeAPIClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/1.0/cloud_ips/cip-12345/map":
+			mapCloudIP(w, r)
+		case "/1.0/cloud_ips/cip-12345":
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"id":"cip-12345","status":"mapped","server":{"id":"srv-12345"}}`))
+		default:
+			http.NotFound(w, r)
+		}
+	})
+	cloudIP, err := assuredMapCloudIP(context.Background(), client, "cip-12345", "srv-12345", time.Minute)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if calls != 3 {
+		t.Errorf("expected 3 attempts to map, got %d", calls)
+	}
+	if cloudIP.Server == nil || cloudIP.Server.ID != "srv-12345" {
+		t.Errorf("cloud IP not mapped to server: %#v", cloudIP)
+	}
+}
+
+func TestAssignCloudIPTimesOutAfterSlowMap(t *testing.T) {
+	client := newFakeAPIClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/1.0/cloud_ips/cip-12345/map":
+			time.Sleep(20 * time.Millisecond)
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusAccepted)
+			w.Write([]byte(`{"id":"cip-12345","status":"unmapped"}`))
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			http.NotFound(w, r)
+		}
+	})
+	_, err := assuredMapCloudIP(context.Background(), client, "cip-12345", "srv-12345", 10*time.Millisecond)
+	var timeoutErr *retry.TimeoutError
+	if !errors.As(err, &timeoutErr) {
+		t.Fatalf("expected a timeout error, got %v", err)
+	}
+}
+
+func TestAssignCloudIPFailsOnOtherErrors(t *testing.T) {
+	var calls int32
+	client := newFakeAPIClient(t, failingHandler(
+		&calls,
+		[]int{http.StatusUnprocessableEntity},
+		[]string{invalidResponse},
+		`{}`,
+	))
+	_, err := assuredMapCloudIP(context.Background(), client, "cip-12345", "srv-12345", time.Minute)
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	if calls != 1 {
+		t.Errorf("expected a single attempt, got %d", calls)
+	}
+}
+
+func TestAttachVolumeRetriesConflicts(t *testing.T) {
+	var calls int32
+	client := newFakeAPIClient(t, failingHandler(
+		&calls,
+		[]int{http.StatusConflict, http.StatusConflict},
+		[]string{conflictResponse, conflictResponse},
+		`{"id":"vol-12345","status":"attached"}`,
+	))
+	volume, err := retryOnConflict(context.Background(), time.Minute, func() (*brightbox.Volume, error) {
+		return client.AttachVolume(context.Background(), "vol-12345", brightbox.VolumeAttachment{Server: "srv-12345"})
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if calls != 3 {
+		t.Errorf("expected 3 attempts to attach, got %d", calls)
+	}
+	if volume.ID != "vol-12345" {
+		t.Errorf("unexpected volume %#v", volume)
+	}
+}
+
+func TestRetryOnConflictGivesUpAtTimeout(t *testing.T) {
+	var calls int32
+	client := newFakeAPIClient(t, func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusConflict)
+		w.Write([]byte(conflictResponse))
+	})
+	_, err := retryOnConflict(context.Background(), time.Second, func() (*brightbox.Volume, error) {
+		return client.AttachVolume(context.Background(), "vol-12345", brightbox.VolumeAttachment{Server: "srv-12345"})
+	})
+	if !isConflictError(err) {
+		t.Errorf("expected the last conflict error, got %v", err)
+	}
+	if calls < 2 {
+		t.Errorf("expected retries before the timeout, got %d attempts", calls)
+	}
+}
+
+func TestServerGroupMembershipCreateRetriesConflicts(t *testing.T) {
+	var calls int32
+	client := newFakeAPIClient(t, failingHandler(
+		&calls,
+		[]int{http.StatusUnprocessableEntity},
+		[]string{busyResponse},
+		`{"id":"grp-12345","servers":[{"id":"srv-12345"}]}`,
+	))
+	resource := resourceBrightboxServerGroupMembership()
+	d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{
+		"group":   "grp-12345",
+		"servers": []interface{}{"srv-12345"},
+	})
+	diags := resourceBrightboxServerGroupMembershipCreate(context.Background(), d, &CompositeClient{APIClient: client})
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if calls != 2 {
+		t.Errorf("expected 2 attempts to add servers, got %d", calls)
+	}
+	if servers := d.Get("servers").(*schema.Set); !servers.Contains("srv-12345") {
+		t.Errorf("server missing from membership: %v", servers.List())
+	}
+}
@@ -0,0 +1,267 @@
+package brightbox
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"sync/atomic"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	tracerName         = "github.com/brightbox/terraform-provider-brightbox"
+	defaultServiceName = "terraform-provider-brightbox"
+)
+
+// Span attribute keys
+const (
+	traceAttributeResourceType = attribute.Key("brightbox.resource_type")
+	traceAttributeResourceID   = attribute.Key("brightbox.resource_id")
+	traceAttributeWaitPending  = attribute.Key("brightbox.wait.pending")
+	traceAttributeWaitTarget   = attribute.Key("brightbox.wait.target")
+	traceAttributeWaitTimeout  = attribute.Key("brightbox.wait.timeout")
+	traceAttributeWaitDelay    = attribute.Key("brightbox.wait.delay")
+	traceAttributeWaitMinimum  = attribute.Key("brightbox.wait.min_timeout")
+	traceAttributeWaitRefresh  = attribute.Key("brightbox.wait.refreshes")
+	traceAttributeWaitState    = attribute.Key("brightbox.wait.state")
+)
+
+// StartTracing installs an OTLP trace exporter if one is configured by
+// the standard OTEL environment variables. The returned function
+// flushes any outstanding spans and stops the exporter.
+func StartTracing(ctx context.Context, version string) (func(context.Context) error, error) {
+	if !tracingEnabled() {
+		return func(context.Context) error { return nil }, nil
+	}
+	exporter, err := traceExporter(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res, err := resource.New(
+		ctx,
+		resource.WithSchemaURL(semconv.SchemaURL),
+		resource.WithAttributes(
+			semconv.ServiceName(defaultServiceName),
+			semconv.ServiceVersion(version),
+		),
+		resource.WithTelemetrySDK(),
+		resource.WithFromEnv(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+	)
+	otel.SetTracerProvider(provider)
+	return provider.Shutdown, nil
+}
+
+// tracingEnabled reports whether the environment asks for traces to
+// be exported
+func tracingEnabled() bool {
+	if strings.EqualFold(os.Getenv("OTEL_SDK_DISABLED"), "true") {
+		return false
+	}
+	switch os.Getenv("OTEL_TRACES_EXPORTER") {
+	case "none":
+		return false
+	case "otlp":
+		return true
+	}
+	return os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT") != "" ||
+		os.Getenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT") != ""
+}
+
+// traceExporter creates an OTLP exporter using the protocol selected
+// in the environment. The exporters read their endpoint, headers and
+// other settings from the environment themselves.
+func traceExporter(ctx context.Context) (sdktrace.SpanExporter, error) {
+	protocol := os.Getenv("OTEL_EXPORTER_OTLP_TRACES_PROTOCOL")
+	if protocol == "" {
+		protocol = os.Getenv("OTEL_EXPORTER_OTLP_PROTOCOL")
+	}
+	switch protocol {
+	case "", "http/protobuf":
+		return otlptracehttp.New(ctx)
+	case "grpc":
+		return otlptracegrpc.New(ctx)
+	default:
+		return nil, fmt.Errorf("unsupported OTLP protocol %q", protocol)
+	}
+}
+
+func tracer() trace.Tracer {
+	return otel.Tracer(tracerName)
+}
+
+type tracedResourceKey struct{}
+
+type tracedResource struct {
+	resourceType string
+	d            *schema.ResourceData
+}
+
+// traceResourceAttributes returns the resource type and ID of the
+// operation running in the context
+func traceResourceAttributes(ctx context.Context) []attribute.KeyValue {
+	traced, ok := ctx.Value(tracedResourceKey{}).(tracedResource)
+	if !ok {
+		return nil
+	}
+	result := []attribute.KeyValue{
+		traceAttributeResourceType.String(traced.resourceType),
+	}
+	if id := traced.d.Id(); id != "" {
+		result = append(result, traceAttributeResourceID.String(id))
+	}
+	return result
+}
+
+// endSpan records the outcome of an operation on its span
+func endSpan(span trace.Span, diags diag.Diagnostics) {
+	if diags.HasError() {
+		err := diagnosticsError(diags)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// addResourceTracing wraps the operations of a resource in spans
+func addResourceTracing(resourceType string, resource *schema.Resource) {
+	resource.CreateContext = withTracing(resourceType, "Create", resource.CreateContext)
+	resource.ReadContext = withTracing(resourceType, "Read", resource.ReadContext)
+	resource.UpdateContext = withTracing(resourceType, "Update", resource.UpdateContext)
+	resource.DeleteContext = withTracing(resourceType, "Delete", resource.DeleteContext)
+	if importer := resource.Importer; importer != nil && importer.StateContext != nil {
+		resource.Importer = &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				ctx, span := startOperationSpan(ctx, resourceType, "Import", d)
+				result, err := importer.StateContext(ctx, d, meta)
+				endSpan(span, diag.FromErr(err))
+				return result, err
+			},
+		}
+	}
+}
+
+// addDataSourceTracing wraps the read of a data source in a span
+func addDataSourceTracing(resourceType string, resource *schema.Resource) {
+	resource.ReadContext = withTracing(resourceType, "ReadDataSource", resource.ReadContext)
+}
+
+func startOperationSpan(ctx context.Context, resourceType string, operation string, d *schema.ResourceData) (context.Context, trace.Span) {
+	ctx = context.WithValue(ctx, tracedResourceKey{}, tracedResource{resourceType, d})
+	return tracer().Start(
+		ctx,
+		resourceType+" "+operation,
+		trace.WithAttributes(traceResourceAttributes(ctx)...),
+	)
+}
+
+func withTracing[F ~func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics](
+	resourceType string,
+	operation string,
+	function F,
+) F {
+	if function == nil {
+		return nil
+	}
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		ctx, span := startOperationSpan(ctx, resourceType, operation, d)
+		diags := function(ctx, d, meta)
+		span.SetAttributes(traceResourceAttributes(ctx)...)
+		endSpan(span, diags)
+		return diags
+	}
+}
+
+// tracingTransport creates a client span for each HTTP request made
+// within a traced operation
+type tracingTransport struct {
+	transport http.RoundTripper
+}
+
+func newTracingTransport(transport http.RoundTripper) http.RoundTripper {
+	return &tracingTransport{transport: transport}
+}
+
+// RoundTrip implements http.RoundTripper
+func (t *tracingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	if !trace.SpanContextFromContext(ctx).IsValid() {
+		return t.transport.RoundTrip(req)
+	}
+	ctx, span := tracer().Start(
+		ctx,
+		req.Method,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.HTTPRequestMethodKey.String(req.Method),
+			semconv.ServerAddress(req.URL.Hostname()),
+			semconv.URLPath(req.URL.Path),
+		),
+		trace.WithAttributes(traceResourceAttributes(ctx)...),
+	)
+	defer span.End()
+	resp, err := t.transport.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return resp, err
+	}
+	span.SetAttributes(semconv.HTTPResponseStatusCode(resp.StatusCode))
+	if resp.StatusCode >= http.StatusBadRequest {
+		span.SetStatus(codes.Error, resp.Status)
+	}
+	return resp, nil
+}
+
+// waitForState runs a state change wait within its own span, recording
+// each refresh of the object as a span event
+func waitForState(ctx context.Context, stateConf *retry.StateChangeConf) (interface{}, error) {
+	ctx, span := tracer().Start(
+		ctx,
+		"wait",
+		trace.WithAttributes(
+			traceAttributeWaitPending.StringSlice(stateConf.Pending),
+			traceAttributeWaitTarget.StringSlice(stateConf.Target),
+			traceAttributeWaitTimeout.String(stateConf.Timeout.String()),
+			traceAttributeWaitDelay.String(stateConf.Delay.String()),
+			traceAttributeWaitMinimum.String(stateConf.MinTimeout.String()),
+		),
+		trace.WithAttributes(traceResourceAttributes(ctx)...),
+	)
+	defer span.End()
+	var refreshes atomic.Int64
+	refresh := stateConf.Refresh
+	stateConf.Refresh = func() (interface{}, string, error) {
+		refreshes.Add(1)
+		result, state, err := refresh()
+		span.AddEvent("refresh", trace.WithAttributes(traceAttributeWaitState.String(state)))
+		return result, state, err
+	}
+	result, err := stateConf.WaitForStateContext(ctx)
+	span.SetAttributes(traceAttributeWaitRefresh.Int64(refreshes.Load()))
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	return result, err
+}
@@ -0,0 +1,160 @@
+package brightbox
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func recordSpans(t *testing.T) *tracetest.SpanRecorder {
+	recorder := tracetest.NewSpanRecorder()
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	t.Cleanup(func() { otel.SetTracerProvider(previous) })
+	return recorder
+}
+
+func spanAttribute(span sdktrace.ReadOnlySpan, key attribute.Key) (attribute.Value, bool) {
+	for _, kv := range span.Attributes() {
+		if kv.Key == key {
+			return kv.Value, true
+		}
+	}
+	return attribute.Value{}, false
+}
+
+func TestTracingSpans(t *testing.T) {
+	recorder := recordSpans(t)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+	client := &http.Client{Transport: newTracingTransport(http.DefaultTransport)}
+
+	resource := resourceBrightboxServer()
+	create := func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, server.URL+"/1.0/servers", nil)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		resp, err := client.Do(req)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		resp.Body.Close()
+		d.SetId("srv-12345")
+		states := []string{"creating", "creating", "active"}
+		_, err = waitForState(ctx, &retry.StateChangeConf{
+			Pending: []string{"creating"},
+			Target:  []string{"active"},
+			Refresh: func() (interface{}, string, error) {
+				state := states[0]
+				states = states[1:]
+				return state, state, nil
+			},
+			Timeout:      time.Second,
+			PollInterval: time.Millisecond,
+		})
+		return diag.FromErr(err)
+	}
+	d := resource.TestResourceData()
+	diags := withTracing("brightbox_server", "Create", create)(context.Background(), d, nil)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	spans := make(map[string]sdktrace.ReadOnlySpan)
+	for _, span := range recorder.Ended() {
+		spans[span.Name()] = span
+	}
+	operation, ok := spans["brightbox_server Create"]
+	if !ok {
+		t.Fatalf("no operation span recorded, got %v", spans)
+	}
+	if value, _ := spanAttribute(operation, traceAttributeResourceID); value.AsString() != "srv-12345" {
+		t.Errorf("operation span resource ID is %q", value.AsString())
+	}
+
+	request, ok := spans[http.MethodPost]
+	if !ok {
+		t.Fatalf("no request span recorded, got %v", spans)
+	}
+	if request.Parent().SpanID() != operation.SpanContext().SpanID() {
+		t.Errorf("request span is not a child of the operation span")
+	}
+	if value, _ := spanAttribute(request, "http.response.status_code"); value.AsInt64() != http.StatusAccepted {
+		t.Errorf("request span status code is %d", value.AsInt64())
+	}
+	if value, _ := spanAttribute(request, traceAttributeResourceType); value.AsString() != "brightbox_server" {
+		t.Errorf("request span resource type is %q", value.AsString())
+	}
+
+	wait, ok := spans["wait"]
+	if !ok {
+		t.Fatalf("no wait span recorded, got %v", spans)
+	}
+	if wait.Parent().SpanID() != operation.SpanContext().SpanID() {
+		t.Errorf("wait span is not a child of the operation span")
+	}
+	if value, _ := spanAttribute(wait, traceAttributeWaitRefresh); value.AsInt64() != 3 {
+		t.Errorf("wait span recorded %d refreshes, expected 3", value.AsInt64())
+	}
+	if value, _ := spanAttribute(wait, traceAttributeResourceID); value.AsString() != "srv-12345" {
+		t.Errorf("wait span resource ID is %q", value.AsString())
+	}
+	if len(wait.Events()) != 3 {
+		t.Errorf("wait span has %d events, expected 3", len(wait.Events()))
+	}
+}
+
+func TestTracingRecordsErrors(t *testing.T) {
+	recorder := recordSpans(t)
+	failing := func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		return diag.Errorf("failed")
+	}
+	d := resourceBrightboxServer().TestResourceData()
+	withTracing("brightbox_server", "Delete", failing)(context.Background(), d, nil)
+	spans := recorder.Ended()
+	if len(spans) != 1 {
+		t.Fatalf("expected one span, got %d", len(spans))
+	}
+	if spans[0].Status().Code != codes.Error {
+		t.Errorf("span status is %v, expected error", spans[0].Status().Code)
+	}
+}
+
+func TestTracingEnabled(t *testing.T) {
+	testCases := []struct {
+		name     string
+		env      map[string]string
+		expected bool
+	}{
+		{"unset", map[string]string{}, false},
+		{"endpoint", map[string]string{"OTEL_EXPORTER_OTLP_ENDPOINT": "http://localhost:4318"}, true},
+		{"traces endpoint", map[string]string{"OTEL_EXPORTER_OTLP_TRACES_ENDPOINT": "http://localhost:4318/v1/traces"}, true},
+		{"exporter", map[string]string{"OTEL_TRACES_EXPORTER": "otlp"}, true},
+		{"exporter none", map[string]string{"OTEL_TRACES_EXPORTER": "none", "OTEL_EXPORTER_OTLP_ENDPOINT": "http://localhost:4318"}, false},
+		{"disabled", map[string]string{"OTEL_SDK_DISABLED": "true", "OTEL_EXPORTER_OTLP_ENDPOINT": "http://localhost:4318"}, false},
+	}
+	for _, tcase := range testCases {
+		t.Run(tcase.name, func(t *testing.T) {
+			for _, key := range []string{"OTEL_SDK_DISABLED", "OTEL_TRACES_EXPORTER", "OTEL_EXPORTER_OTLP_ENDPOINT", "OTEL_EXPORTER_OTLP_TRACES_ENDPOINT"} {
+				t.Setenv(key, tcase.env[key])
+			}
+			if result := tracingEnabled(); result != tcase.expected {
+				t.Errorf("expected %v, got %v", tcase.expected, result)
+			}
+		})
+	}
+}
@@ -11,31 +11,40 @@ import (
 	"math"
 	"os"
 	"regexp"
+	"slices"
 	"sort"
+	"strconv"
+	"strings"
 	"time"
 
 	brightbox "github.com/brightbox/gobrightbox/v2"
 	"github.com/gophercloud/gophercloud"
 	"github.com/gorhill/cronexpr"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 var (
+	accountRegexp          = regexp.MustCompile("^acc-.....$")
+	apiClientRegexp        = regexp.MustCompile("^cli-.....$")
 	serverRegexp           = regexp.MustCompile("^srv-.....$")
 	serverGroupRegexp      = regexp.MustCompile("^grp-.....$")
+	cloudIPRegexp          = regexp.MustCompile("^cip-.....$")
 	databaseTypeRegexp     = regexp.MustCompile("^dbt-.....$")
 	databaseServerRegexp   = regexp.MustCompile("^dbs-.....$")
 	databaseSnapshotRegexp = regexp.MustCompile("^dbi-.....$")
 	loadBalancerRegexp     = regexp.MustCompile("^lba-.....$")
-	zoneRegexp             = regexp.MustCompile("^(zon-.....$|gb1s?-[ab])$")
+	zoneRegexp             = regexp.MustCompile(`^(zon-.....|[a-z]+[0-9]+s?-[a-z])$`)
+	zoneIDRegexp           = regexp.MustCompile("^zon-.....$")
 	serverTypeRegexp       = regexp.MustCompile("^typ-.....$")
 	firewallPolicyRegexp   = regexp.MustCompile("^fwp-.....$")
 	firewallRuleRegexp     = regexp.MustCompile("^fwr-.....$")
 	interfaceRegexp        = regexp.MustCompile("^int-.....$")
 	imageRegexp            = regexp.MustCompile("^img-.....$")
 	volumeRegexp           = regexp.MustCompile("^vol-.....$")
-	dnsNameRegexp          = regexp.MustCompile("^(?:[a-z0-9](?:[a-z0-9-]{0,61}[a-z0-9])?.)+[a-z0-9][a-z0-9-]{0,61}[a-z0-9]$")
+	dnsNameRegexp          = regexp.MustCompile(`^(?:[a-z0-9](?:[a-z0-9-]{0,61}[a-z0-9])?\.)+[a-z0-9][a-z0-9-]{0,61}[a-z0-9]$`)
 	unreadable             = map[string]bool{
 		"deleted": true,
 		"failed":  true,
@@ -43,6 +52,39 @@ var (
 	validDatabaseEngines = []string{"mysql", "postgresql"}
 )
 
+// idKinds names the kind of object identified by each ID pattern
+var idKinds = []struct {
+	kind    string
+	pattern *regexp.Regexp
+}{
+	{"account", accountRegexp},
+	{"api_client", apiClientRegexp},
+	{"cloudip", cloudIPRegexp},
+	{"database_server", databaseServerRegexp},
+	{"database_snapshot", databaseSnapshotRegexp},
+	{"database_type", databaseTypeRegexp},
+	{"firewall_policy", firewallPolicyRegexp},
+	{"firewall_rule", firewallRuleRegexp},
+	{"image", imageRegexp},
+	{"interface", interfaceRegexp},
+	{"load_balancer", loadBalancerRegexp},
+	{"server", serverRegexp},
+	{"server_group", serverGroupRegexp},
+	{"server_type", serverTypeRegexp},
+	{"volume", volumeRegexp},
+	{"zone", zoneIDRegexp},
+}
+
+// idKind returns the kind of object an ID identifies
+func idKind(id string) (string, bool) {
+	for _, entry := range idKinds {
+		if entry.pattern.MatchString(id) {
+			return entry.kind, true
+		}
+	}
+	return "", false
+}
+
 func timeFromFloat(timeFloat float64) time.Time {
 	sec, dec := math.Modf(timeFloat)
 	return time.Unix(int64(sec), int64(dec*(1e9)))
@@ -90,6 +132,34 @@ func assignString(d *schema.ResourceData, target **string, index string) {
 	}
 }
 
+// assignWriteOnlyString sends the write-only attribute index whenever
+// its version attribute changes
+func assignWriteOnlyString(d *schema.ResourceData, target **string, index string, versionIndex string) diag.Diagnostics {
+	if !d.HasChange(versionIndex) {
+		return nil
+	}
+	value, diags := writeOnlyString(d, index)
+	if diags.HasError() {
+		return diags
+	}
+	*target = &value
+	return diags
+}
+
+// writeOnlyString returns the configured value of a write-only
+// attribute. These are never in the plan or state, so they are read
+// from the configuration, and are empty when unset.
+func writeOnlyString(d *schema.ResourceData, index string) (string, diag.Diagnostics) {
+	value, diags := d.GetRawConfigAt(cty.GetAttrPath(index))
+	if diags.HasError() {
+		return "", diags
+	}
+	if !value.Type().Equals(cty.String) || value.IsNull() || !value.IsKnown() {
+		return "", diags
+	}
+	return value.AsString(), diags
+}
+
 func assignStringSet(d *schema.ResourceData, target *[]string, index string) {
 	if d.HasChange(index) {
 		*target = sliceFromStringSet(d, index)
@@ -441,6 +511,16 @@ func mostRecent[S ~[]I, I brightbox.CreateDated](items S) *I {
 	return &sortedItems[0]
 }
 
+// Returns the smallest item out of a slice of items, as ordered by less.
+// The items are left in their original order.
+func smallest[S ~[]I, I any](items S, less func(a, b I) bool) *I {
+	sortedItems := slices.Clone(items)
+	sort.SliceStable(sortedItems, func(i, j int) bool {
+		return less(sortedItems[i], sortedItems[j])
+	})
+	return &sortedItems[0]
+}
+
 // filter returns a new slice with all elements from the from the
 // input elements for which the provided predicate function returns true.
 func filter[S ~[]T, T any](input S, pred func(T) bool) (output S) {
@@ -452,6 +532,12 @@ func filter[S ~[]T, T any](input S, pred func(T) bool) (output S) {
 	return output
 }
 
+// listID derives a stable identifier for a data source listing the
+// given object IDs
+func listID(ids []string) string {
+	return strconv.Itoa(HashcodeString(strings.Join(ids, ",")))
+}
+
 // idList returns a list of identifiers from a list of identifiable objects
 func idList[S ~[]O, O any](list S, identify func(v O) string) []string {
 	ids := make([]string, len(list))
@@ -159,6 +159,13 @@ func TestFilter(t *testing.T) {
 	assert.DeepEqual(t, []int{30, 31, 32, 33}, output)
 }
 
+func TestSmallestLeavesOrder(t *testing.T) {
+	testData := []int{3, 1, 2}
+	result := smallest(testData, func(a, b int) bool { return a < b })
+	assert.Equal(t, 1, *result)
+	assert.DeepEqual(t, []int{3, 1, 2}, testData)
+}
+
 func TestIntersection(t *testing.T) {
 	assert.DeepEqual(t, []string{"c", "d"}, Intersection([]string{"a", "c", "d"}, []string{"b", "c", "d"}))
 }
@@ -67,7 +67,7 @@ resource "brightbox_server" "web" {
 }
 
 data "brightbox_image" "ubuntu_lts" {
-  name        = "^ubuntu-xenial.*server$"
+  name_regex  = "^ubuntu-xenial.*server$"
   arch        = "x86_64"
   official    = true
   most_recent = true
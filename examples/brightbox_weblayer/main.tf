@@ -107,7 +107,7 @@ resource "brightbox_orbit_container" "backups" {
 resource "brightbox_database_server" "database" {
   name                = "Terraform weblayer example database"
   database_engine     = "mysql"
-  database_version    = "5.6"
+  database_version    = "8.0"
   database_type       = data.brightbox_database_type.four_gb.id
   maintenance_weekday = 6
   maintenance_hour    = 6
@@ -183,7 +183,7 @@ resource "brightbox_server" "server2" {
 }
 
 data "brightbox_image" "ubuntu_lts" {
-  name        = var.web_image
+  name_regex  = var.web_image
   arch        = "x86_64"
   official    = true
   most_recent = true
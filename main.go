@@ -4,9 +4,9 @@ import (
 	"context"
 	"flag"
 	"log"
+	"os"
 
 	sdkprovider "github.com/brightbox/terraform-provider-brightbox/brightbox"
-	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5/tf5server"
 	"github.com/hashicorp/terraform-plugin-mux/tf5muxserver"
 )
@@ -18,6 +18,10 @@ var (
 )
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "generate" {
+		generate(os.Args[2:])
+		return
+	}
 	debugFlag := flag.Bool("debug", false, "Start provider in debug mode.")
 	flag.Parse()
 	opts := []tf5server.ServeOpt{}
@@ -26,15 +30,16 @@ func main() {
 		opts = append(opts, tf5server.WithManagedDebug())
 	}
 
-	providers := []func() tfprotov5.ProviderServer{
-		//providerserver.NewProtocol5(provider.New(version)),
-		sdkprovider.Provider().GRPCProvider,
+	shutdownTracing, err := sdkprovider.StartTracing(context.Background(), version)
+	if err != nil {
+		log.Printf("[WARN] Unable to start tracing: %s", err)
+		shutdownTracing = func(context.Context) error { return nil }
 	}
 
 	// use the muxer
-	muxServer, err := tf5muxserver.NewMuxServer(context.Background(), providers...)
+	muxServer, err := tf5muxserver.NewMuxServer(context.Background(), sdkprovider.ProviderServers(version)...)
 	if err != nil {
-		log.Fatalf(err.Error())
+		log.Fatal(err.Error())
 	}
 
 	err = tf5server.Serve(
@@ -42,7 +47,21 @@ func main() {
 		muxServer.ProviderServer,
 		opts...,
 	)
+	if shutdownErr := shutdownTracing(context.Background()); shutdownErr != nil {
+		log.Printf("[WARN] Unable to flush traces: %s", shutdownErr)
+	}
 	if err != nil {
 		log.Fatal(err.Error())
 	}
 }
+
+// generate writes configuration and import blocks for the objects in
+// an account, authenticating from the BRIGHTBOX_* environment variables
+func generate(args []string) {
+	generateFlags := flag.NewFlagSet("generate", flag.ExitOnError)
+	dir := generateFlags.String("dir", ".", "Directory to write the generated configuration into.")
+	generateFlags.Parse(args)
+	if err := sdkprovider.Generate(context.Background(), *dir, os.Stderr); err != nil {
+		log.Fatal(err.Error())
+	}
+}